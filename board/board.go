@@ -0,0 +1,519 @@
+package board
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jordanella/teaspoon"
+	"github.com/jordanella/teaspoon/list"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Board Item
+/*
+ - Items are rendered and marked by their column, matching the rows of a reorderable list
+*/
+type Item = list.Item
+
+//* Default Drag Type
+/*
+ - Given to items without a Drag handler, and accepted by columns unless their acceptance rules are changed
+*/
+const DragType = "teaspoon/board.item"
+
+//* Transfer Event
+/*
+ - Emitted when an item is dropped into a column, including drops within the column it came from
+ - FromContainer and ToContainer are column IDs, Index is the item's position in the target column
+*/
+type TransferEvent struct {
+	ID            string
+	Item          Item
+	FromContainer string
+	ToContainer   string
+	Index         int
+}
+
+//* Animation Frame
+/*
+ - Scheduled message advancing the landing gap animation of the board matching ID
+*/
+type AnimationFrame struct {
+	ID string
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Board Styles
+/*
+ - Column borders reflect whether the item currently dragged over them would be accepted
+*/
+type Styles struct {
+	Column      lipgloss.Style
+	Accept      lipgloss.Style
+	Deny        lipgloss.Style
+	Title       lipgloss.Style
+	Dragging    lipgloss.Style
+	Placeholder lipgloss.Style
+}
+
+//* Default Board Styles
+/*
+ - Returns rounded column borders which turn green or red while an item is dragged over them
+*/
+func DefaultStyles() Styles {
+	column := lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+	return Styles{
+		Column:      column,
+		Accept:      column.BorderForeground(lipgloss.Color("#44aa44")),
+		Deny:        column.BorderForeground(lipgloss.Color("#aa4444")),
+		Title:       lipgloss.NewStyle().Bold(true).MarginBottom(1),
+		Dragging:    lipgloss.NewStyle().Faint(true),
+		Placeholder: lipgloss.NewStyle().Foreground(lipgloss.Color("#44aaaa")).Faint(true),
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Board Column
+/*
+ - A titled container of items acting as a drop target
+ - Acceptance rules are configured through the column's DropHandler AcceptedDropTypes and IsAcceptable
+*/
+type Column struct {
+	Title string
+	Items []Item
+
+	insert      int
+	landing     int
+	landingID   string
+	shown       int
+	opening     int
+	closedAt    int
+	closing     int
+	gapHeight   int
+	interaction *teaspoon.Interactable
+	drop        *teaspoon.DropHandler
+}
+
+//* Column Creation Method
+/*
+ - Returns a new column accepting items of the default DragType
+*/
+func NewColumn(title string, items ...Item) Column {
+	drop := &teaspoon.DropHandler{
+		AcceptedDropTypes: []string{DragType},
+	}
+	drop.OnDropHover = func(element teaspoon.Interactive, dragEvent teaspoon.DragEvent) (teaspoon.Interactive, tea.Cmd) {
+		c := element.(Column)
+		c.insert = -1
+		if drop.HandleIsAcceptable(c, dragEvent) {
+			c.insert = c.insertionIndex(dragEvent.MouseMsg, c.indexOf(dragEvent.ID))
+		}
+		return drop.DefaultDropHover(c, dragEvent)
+	}
+	drop.OnDropLeave = func(element teaspoon.Interactive, dragEvent teaspoon.DragEvent) (teaspoon.Interactive, tea.Cmd) {
+		c := element.(Column)
+		c.insert = -1
+		return drop.DefaultDropLeave(c, dragEvent)
+	}
+	drop.OnDropRelease = func(element teaspoon.Interactive, dragEvent teaspoon.DragEvent) (teaspoon.Interactive, tea.Cmd) {
+		c := element.(Column)
+		c.insert = -1
+		if drop.HandleIsAcceptable(c, dragEvent) {
+			c.landing = c.insertionIndex(dragEvent.MouseMsg, c.indexOf(dragEvent.ID))
+			c.landingID = dragEvent.ID
+		}
+		return drop.DefaultDropRelease(c, dragEvent)
+	}
+
+	return Column{
+		Title:    title,
+		Items:    items,
+		insert:   -1,
+		landing:  -1,
+		shown:    -1,
+		closedAt: -1,
+		interaction: &teaspoon.Interactable{
+			ID:   zone.NewPrefix(),
+			Drop: drop,
+		},
+		drop: drop,
+	}
+}
+
+//* Implement Interactive Interface
+/*
+ - Provides reference to the column's interaction, used as the drop target for items
+*/
+func (c Column) GetInteraction() *teaspoon.Interactable {
+	return c.interaction
+}
+
+//* Column Drop Handler
+/*
+ - Provides access to the column's acceptance rules, AcceptedDropTypes and IsAcceptable
+*/
+func (c Column) DropHandler() *teaspoon.DropHandler {
+	return c.drop
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Kanban Board
+/*
+ - Moves items between columns by dragging, respecting each column's acceptance rules
+ - A gap opens where the dragged item will land and denying columns are highlighted
+ - The gap opens and the previous gap closes over AnimationFrames frames, or immediately if AnimationFrames is 0
+*/
+type Model struct {
+	Columns         []Column
+	ColumnWidth     int
+	AnimationFrames int
+	Styles          Styles
+
+	id        string
+	drag      *teaspoon.DragHandler
+	animating bool
+}
+
+//* Creation Method
+/*
+ - Returns a new board containing the provided columns
+ - Items without handlers are given a Click handler and a Drag handler of the default DragType
+*/
+func New(columns ...Column) Model {
+	m := Model{
+		ColumnWidth:     24,
+		AnimationFrames: 6,
+		Styles:          DefaultStyles(),
+		id:              zone.NewPrefix(),
+		drag: &teaspoon.DragHandler{
+			DragType:     DragType,
+			EmitMessages: true,
+		},
+	}
+	return m.SetColumns(columns)
+}
+
+//* Set Columns
+/*
+ - Replaces the board's columns, preparing their items for dragging
+*/
+func (m Model) SetColumns(columns []Column) Model {
+	for _, column := range columns {
+		for _, item := range column.Items {
+			m.prepare(item)
+		}
+	}
+	m.Columns = columns
+	return m
+}
+
+//* Board ID
+/*
+ - Returns the ID attached to the board's TransferEvents
+*/
+func (m Model) ID() string {
+	return m.id
+}
+
+func (m Model) prepare(item Item) {
+	interaction := item.GetInteraction()
+	if interaction.Click == nil {
+		interaction.Click = &teaspoon.ClickHandler{
+			OnClick: func(element teaspoon.Interactive, mouseMsg tea.MouseMsg) (teaspoon.Interactive, tea.Cmd) {
+				return element, nil
+			},
+		}
+	}
+	if interaction.Drag == nil {
+		interaction.Drag = m.drag
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Update Routine
+/*
+ - Mouse messages are passed to every item so drags continue outside of their column
+ - Drag events are assessed by every column, and accepted releases are transferred afterwards
+ - Animation frames advance the landing gaps until they are fully open or closed
+ - Other external messages are passed to the items so drags can be cancelled
+*/
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.MouseMsg:
+		if tea.MouseEvent(msg).IsWheel() {
+			return m, nil
+		}
+		for _, column := range m.Columns {
+			cmds = append(cmds, teaspoon.Forward(column.Items, msg))
+		}
+		return m, tea.Batch(cmds...)
+
+	case AnimationFrame:
+		if msg.ID != m.id {
+			return m, nil
+		}
+		m.animating = false
+		for c := range m.Columns {
+			m.Columns[c].advance(m.AnimationFrames)
+		}
+		return m.scheduleFrame()
+
+	case teaspoon.DragEvent:
+		for c, column := range m.Columns {
+			var element teaspoon.Interactive
+			element, cmd = column.interaction.HandleExternalEvent(column, msg)
+			m.Columns[c] = element.(Column)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+		for c := range m.Columns {
+			if m.Columns[c].landingID != "" {
+				m, cmd = m.transfer(c)
+				cmds = append(cmds, cmd)
+			}
+		}
+		m, cmd = m.animate(msg.EventType == teaspoon.DragEnd)
+		cmds = append(cmds, cmd)
+	}
+
+	for _, column := range m.Columns {
+		cmds = append(cmds, teaspoon.Forward(column.Items, msg))
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+//* Item Transfer
+/*
+ - Moves the item which landed in the given column from its source column and emits a TransferEvent
+*/
+func (m Model) transfer(target int) (Model, tea.Cmd) {
+	id := m.Columns[target].landingID
+	index := m.Columns[target].landing
+	m.Columns[target].landingID = ""
+	m.Columns[target].landing = -1
+
+	source, from := -1, -1
+	for c, column := range m.Columns {
+		if i := column.indexOf(id); i >= 0 {
+			source, from = c, i
+			break
+		}
+	}
+	if source < 0 {
+		return m, nil
+	}
+
+	item := m.Columns[source].Items[from]
+	if source == target && index > from {
+		index--
+	}
+
+	sourceItems := make([]Item, 0, len(m.Columns[source].Items))
+	sourceItems = append(sourceItems, m.Columns[source].Items[:from]...)
+	m.Columns[source].Items = append(sourceItems, m.Columns[source].Items[from+1:]...)
+
+	targetItems := m.Columns[target].Items
+	index = max(0, min(index, len(targetItems)))
+	m.Columns[target].Items = append(targetItems[:index:index], append([]Item{item}, targetItems[index:]...)...)
+
+	transferEvent := TransferEvent{
+		ID:            m.id,
+		Item:          item,
+		FromContainer: m.Columns[source].interaction.ID,
+		ToContainer:   m.Columns[target].interaction.ID,
+		Index:         index,
+	}
+	return m, func() tea.Msg {
+		return transferEvent
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Gap Animation
+/*
+ - Moves each column's gap to its insertion index, collapsing the gap it leaves, and schedules a frame if needed
+ - Completed drops settle immediately, as the item now occupies its gap
+*/
+func (m Model) animate(settle bool) (Model, tea.Cmd) {
+	height := 0
+	if dragged := m.dragged(); dragged != nil {
+		height = lipgloss.Height(dragged.View())
+	}
+	for c := range m.Columns {
+		column := &m.Columns[c]
+		if settle {
+			column.shown, column.opening, column.closedAt, column.closing = -1, 0, -1, 0
+			continue
+		}
+		if height > 0 {
+			column.gapHeight = height
+		}
+		if column.insert != column.shown {
+			if column.shown >= 0 && column.opening > 0 {
+				column.closedAt, column.closing = column.shown, column.opening
+			}
+			column.shown, column.opening = column.insert, 0
+		}
+		if m.AnimationFrames <= 0 {
+			column.advance(0)
+		}
+	}
+	return m.scheduleFrame()
+}
+
+//* Frame Scheduling
+/*
+ - Schedules a single AnimationFrame while any gap is still opening or closing
+*/
+func (m Model) scheduleFrame() (Model, tea.Cmd) {
+	if m.animating || m.AnimationFrames <= 0 {
+		return m, nil
+	}
+	for _, column := range m.Columns {
+		if (column.shown >= 0 && column.opening < m.AnimationFrames) || column.closing > 0 {
+			m.animating = true
+			id := m.id
+			return m, tea.Tick(teaspoon.FrameInterval, func(time.Time) tea.Msg {
+				return AnimationFrame{ID: id}
+			})
+		}
+	}
+	return m, nil
+}
+
+func (c *Column) advance(frames int) {
+	if frames <= 0 {
+		c.closedAt, c.closing = -1, 0
+		return
+	}
+	if c.shown >= 0 && c.opening < frames {
+		c.opening++
+	}
+	if c.closing > 0 {
+		c.closing--
+	}
+	if c.closing == 0 {
+		c.closedAt = -1
+	}
+}
+
+//* Gap Lines
+/*
+ - Returns the number of lines of a gap at the given progress, rounded up so a gap is visible from its first frame
+*/
+func (c Column) gapLines(progress, frames int) int {
+	if frames <= 0 {
+		return c.gapHeight
+	}
+	return (c.gapHeight*progress + frames - 1) / frames
+}
+
+func (m Model) dragged() Item {
+	for _, column := range m.Columns {
+		for _, item := range column.Items {
+			if item.GetInteraction().IsDragging {
+				return item
+			}
+		}
+	}
+	return nil
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Board View
+/*
+ - Renders the columns side by side, marking each column and item with its ID
+*/
+func (m Model) View() string {
+	dragged := m.dragged()
+
+	var columns []string
+	for _, column := range m.Columns {
+		columns = append(columns, column.view(m.Styles, m.ColumnWidth, m.AnimationFrames, dragged))
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, columns...)
+}
+
+//* Column View
+/*
+ - Renders the title and items, with the opening gap showing as much of the dragged item as it has opened
+ - A closing gap is drawn as blank lines until it has collapsed
+*/
+func (c Column) view(styles Styles, width, frames int, dragged Item) string {
+	rows := []string{styles.Title.Render(c.Title)}
+
+	gaps := func(i int) {
+		if i == c.closedAt {
+			for range c.gapLines(c.closing, frames) {
+				rows = append(rows, "")
+			}
+		}
+		if i == c.shown && dragged != nil {
+			lines := strings.Split(dragged.View(), "\n")
+			lines = lines[:min(c.gapLines(c.opening, frames), len(lines))]
+			if len(lines) > 0 {
+				rows = append(rows, styles.Placeholder.Render(strings.Join(lines, "\n")))
+			}
+		}
+	}
+
+	for i, item := range c.Items {
+		gaps(i)
+		interaction := item.GetInteraction()
+		style := lipgloss.NewStyle()
+		if interaction.IsDragging {
+			style = styles.Dragging
+		}
+		rows = append(rows, zone.Mark(interaction.ID, style.Render(item.View())))
+	}
+	for i := len(c.Items); i <= max(c.shown, c.closedAt); i++ {
+		gaps(i)
+	}
+
+	style := styles.Column
+	if c.interaction.IsBelowDrop {
+		if c.interaction.IsValidDrop {
+			style = styles.Accept
+		} else {
+			style = styles.Deny
+		}
+	}
+
+	return zone.Mark(c.interaction.ID, style.Width(width).Render(lipgloss.JoinVertical(lipgloss.Left, rows...)))
+}
+
+//* Insertion Index
+/*
+ - Returns the index of the gap under the pointer among the column's items
+*/
+func (c Column) insertionIndex(mouseMsg tea.MouseMsg, from int) int {
+	items := make([]teaspoon.Interactive, 0, len(c.Items))
+	for _, item := range c.Items {
+		items = append(items, item)
+	}
+	return teaspoon.InsertionIndex(teaspoon.YAxis, mouseMsg, from, 0, items...)
+}
+
+func (c Column) indexOf(id string) int {
+	for i, item := range c.Items {
+		if item.GetInteraction().ID == id {
+			return i
+		}
+	}
+	return -1
+}
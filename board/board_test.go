@@ -0,0 +1,119 @@
+package board
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jordanella/teaspoon"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+type item struct {
+	label       string
+	interaction *teaspoon.Interactable
+}
+
+func (i item) GetInteraction() *teaspoon.Interactable {
+	return i.interaction
+}
+
+func (i item) View() string {
+	return i.label
+}
+
+func newItem(label string) Item {
+	return item{label: label, interaction: &teaspoon.Interactable{ID: zone.NewPrefix()}}
+}
+
+// Scans the view and waits for the zone with the given ID to be stored again
+func scan(t *testing.T, view, id string) {
+	t.Helper()
+	zone.Clear(id)
+	zone.Scan(view)
+	for deadline := time.Now().Add(time.Second); zone.Get(id).IsZero(); time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("zones were not stored")
+		}
+	}
+}
+
+// Updates the model with the message and every message its commands produce, returning those messages
+func run(m Model, msg tea.Msg) (Model, []tea.Msg) {
+	var produced []tea.Msg
+	queue := []tea.Msg{msg}
+	for len(queue) > 0 {
+		var cmd tea.Cmd
+		m, cmd = m.Update(queue[0])
+		queue = append(queue[1:], messages(cmd)...)
+		produced = append(produced, messages(cmd)...)
+	}
+	return m, produced
+}
+
+func messages(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	switch msg := cmd().(type) {
+	case nil:
+		return nil
+	case tea.BatchMsg:
+		var msgs []tea.Msg
+		for _, cmd := range msg {
+			msgs = append(msgs, messages(cmd)...)
+		}
+		return msgs
+	default:
+		return []tea.Msg{msg}
+	}
+}
+
+func labels(column Column) []string {
+	var labels []string
+	for _, i := range column.Items {
+		labels = append(labels, i.View())
+	}
+	return labels
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+func TestBoardTransfer(t *testing.T) {
+	zone.NewGlobal()
+	locked := NewColumn("Locked", newItem("z"))
+	locked.DropHandler().AcceptedDropTypes = []string{"other"}
+	m := New(NewColumn("Todo", newItem("a"), newItem("b")), NewColumn("Done", newItem("x")), locked)
+	m.AnimationFrames = 0
+	scan(t, m.View(), m.Columns[2].Items[0].GetInteraction().ID)
+
+	drag := func(from, to teaspoon.Interactive) []tea.Msg {
+		start := zone.Get(from.GetInteraction().ID)
+		end := zone.Get(to.GetInteraction().ID)
+		var produced []tea.Msg
+		m, _ = run(m, tea.MouseMsg{X: start.StartX, Y: start.StartY, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+		m, _ = run(m, tea.MouseMsg{X: end.StartX, Y: end.StartY, Action: tea.MouseActionMotion, Button: tea.MouseButtonLeft})
+		m, produced = run(m, tea.MouseMsg{X: end.StartX, Y: end.StartY, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft})
+		return produced
+	}
+
+	a := m.Columns[0].Items[0]
+	produced := drag(a, m.Columns[1].Items[0])
+	if got := [][]string{labels(m.Columns[0]), labels(m.Columns[1])}; !slices.Equal(got[0], []string{"b"}) || !slices.Equal(got[1], []string{"a", "x"}) {
+		t.Errorf("columns %v after the transfer, want [[b] [a x]]", got)
+	}
+	want := TransferEvent{ID: m.id, Item: a, FromContainer: m.Columns[0].interaction.ID, ToContainer: m.Columns[1].interaction.ID}
+	if !slices.Contains(produced, tea.Msg(want)) {
+		t.Errorf("no transfer event to index 0 of Done in %v", produced)
+	}
+
+	scan(t, m.View(), a.GetInteraction().ID)
+	drag(a, m.Columns[2].Items[0])
+	if got := labels(m.Columns[2]); !slices.Equal(got, []string{"z"}) {
+		t.Errorf("locked column holds %v after a denied drop, want [z]", got)
+	}
+	if got := labels(m.Columns[1]); !slices.Equal(got, []string{"a", "x"}) {
+		t.Errorf("Done holds %v after a denied drop, want [a x]", got)
+	}
+}
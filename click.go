@@ -110,12 +110,11 @@ func (h *ClickHandler) HandleDoubleClick(element Interactive, mouseMsg tea.Mouse
 
 //* Default Double Click Behaviour
 /*
- - Sets an element's MouseInteraction IsSelected property to true
+ - Leaves the element unchanged, as the press has already been handled as a click
 */
 func (h *ClickHandler) DefaultDoubleClick(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
 
 	interaction := element.GetInteraction()
-	interaction.IsSelected = true
 
 	if h.EmitMessages {
 		cmd := func() tea.Msg {
@@ -134,28 +133,27 @@ func (h *ClickHandler) DefaultDoubleClick(element Interactive, mouseMsg tea.Mous
 
 //* Right Click Handler
 /*
- - Sets an element's MouseInteraction IsSelected property to true
+ - Responds to localized right click events with OnRightClick function or DefaultRightClick if it is not defined
 */
 func (h *ClickHandler) HandleRightClick(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
 	if h.OnRightClick != nil {
 		return h.OnRightClick(element, mouseMsg)
 	}
-	return element, nil
+	return h.DefaultRightClick(element, mouseMsg)
 }
 
 //* Default Right Click Behaviour
 /*
- - Sets an element's MouseInteraction IsSelected property to true
+ - Leaves the element unchanged, so right clicks never select outside of a component's own selection handling
 */
 func (h *ClickHandler) DefaultRightClick(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
 
 	interaction := element.GetInteraction()
-	interaction.IsSelected = true
 
 	if h.EmitMessages {
 		cmd := func() tea.Msg {
 			return ClickEvent{
-				EventType: DoubleClick,
+				EventType: RightClick,
 				ID:        interaction.ID,
 				MouseMsg:  mouseMsg,
 			}
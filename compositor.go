@@ -0,0 +1,215 @@
+package teaspoon
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Layer
+/*
+ - A rendered view drawn over the base view with its top left cell at X and Y
+ - Layers with a higher Z are drawn above those with a lower Z, equal layers are drawn in order
+*/
+type Layer struct {
+	View string
+	X, Y int
+	Z    int
+}
+
+//* Layer Bounds Assessment
+/*
+ - Returns true if the cell is covered by the layer's view
+*/
+func (l Layer) Contains(x, y int) bool {
+	width, height := lipgloss.Size(l.View)
+	return x >= l.X && x < l.X+width && y >= l.Y && y < l.Y+height
+}
+
+//* Topmost Layer
+/*
+ - Returns the index of the highest layer covering the cell, or -1 if the cell shows the base view
+*/
+func TopLayer(x, y int, layers ...Layer) int {
+	top := -1
+	for i, layer := range layers {
+		if layer.Contains(x, y) && (top < 0 || layer.Z >= layers[top].Z) {
+			top = i
+		}
+	}
+	return top
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Compose Layers
+/*
+ - Draws the layers over the base view cell by cell, preserving the styles of both and splitting wide runes cleanly
+ - Zone markers travel with the cells they mark, so a zone.Scan of the result registers layer zones where they are drawn
+ - Markers of covered base cells are kept at the layer's edge so their zones stay balanced
+ - Covered zones still span the layer, so pass the same layers to Registry.SetLayers for occlusion-aware hit testing
+ - Lines shorter than the layer's widest are padded with blank cells, so a layer covers the rectangle that SetLayers records
+*/
+func Compose(base string, layers ...Layer) string {
+	ordered := append([]Layer{}, layers...)
+	sort.SliceStable(ordered, func(a, b int) bool {
+		return ordered[a].Z < ordered[b].Z
+	})
+
+	lines := parseCells(base)
+	for _, layer := range ordered {
+		layerLines := parseCells(layer.View)
+		width := 0
+		for _, layerLine := range layerLines {
+			width = max(width, len(layerLine.cells))
+		}
+		for j, layerLine := range layerLines {
+			layerLine.pad(width)
+			y := layer.Y + j
+			if y < 0 {
+				continue
+			}
+			for len(lines) <= y {
+				lines = append(lines, cellLine{})
+			}
+			lines[y].overlay(layer.X, layerLine)
+		}
+	}
+
+	rendered := make([]string, len(lines))
+	for i, line := range lines {
+		rendered[i] = line.render()
+	}
+	return strings.Join(rendered, "\n")
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Cell Parsing
+/*
+ - Splits a view into lines of single column cells, each carrying its active style and preceding zero width sequences
+ - The second column of a wide rune is held as an empty continuation cell
+*/
+type cell struct {
+	style   string
+	text    string
+	markers string
+}
+
+type cellLine struct {
+	cells []cell
+	tail  string
+}
+
+func parseCells(view string) []cellLine {
+	var lines []cellLine
+	for _, text := range strings.Split(view, "\n") {
+		var line cellLine
+		var style, markers string
+		var state byte
+		for len(text) > 0 {
+			seq, width, n, newState := ansi.DecodeSequence(text, state, nil)
+			state, text = newState, text[n:]
+			switch {
+			case width > 0:
+				line.cells = append(line.cells, cell{style: style, text: seq, markers: markers})
+				for k := 1; k < width; k++ {
+					line.cells = append(line.cells, cell{style: style})
+				}
+				markers = ""
+			case strings.HasPrefix(seq, "\x1b[") && strings.HasSuffix(seq, "m"):
+				if seq == "\x1b[m" || seq == "\x1b[0m" {
+					style = ""
+				} else {
+					style += seq
+				}
+			case seq == "\r":
+			default:
+				markers += seq
+			}
+		}
+		line.tail = markers
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func (l *cellLine) pad(width int) {
+	if len(l.cells) >= width {
+		return
+	}
+	l.cells = append(l.cells, cell{text: " ", markers: l.tail})
+	for len(l.cells) < width {
+		l.cells = append(l.cells, cell{text: " "})
+	}
+	l.tail = ""
+}
+
+func (l *cellLine) overlay(x int, layer cellLine) {
+	cells := layer.cells
+	if x < 0 {
+		if -x >= len(cells) {
+			return
+		}
+		cells = cells[-x:]
+		x = 0
+		if len(cells) > 0 && cells[0].text == "" {
+			cells[0] = cell{style: cells[0].style, text: " ", markers: cells[0].markers}
+		}
+	}
+	if len(cells) == 0 {
+		return
+	}
+
+	end := x + len(cells)
+	for len(l.cells) < end {
+		l.cells = append(l.cells, cell{text: " "})
+	}
+
+	if x > 0 && l.cells[x].text == "" {
+		l.cells[x-1].text = " "
+	}
+	if end < len(l.cells) && l.cells[end].text == "" {
+		l.cells[end].text = " "
+	}
+
+	var covered string
+	for _, c := range l.cells[x:end] {
+		covered += c.markers
+	}
+	cells = append([]cell{}, cells...)
+	cells[0].markers = covered + cells[0].markers
+	copy(l.cells[x:end], cells)
+
+	if end < len(l.cells) {
+		l.cells[end].markers = layer.tail + l.cells[end].markers
+	} else {
+		l.tail = layer.tail + l.tail
+	}
+}
+
+func (l cellLine) render() string {
+	var b strings.Builder
+	style := ""
+	for _, c := range l.cells {
+		b.WriteString(c.markers)
+		if c.text == "" {
+			continue
+		}
+		if c.style != style {
+			b.WriteString("\x1b[0m")
+			b.WriteString(c.style)
+			style = c.style
+		}
+		b.WriteString(c.text)
+	}
+	if style != "" {
+		b.WriteString("\x1b[0m")
+	}
+	b.WriteString(l.tail)
+	return b.String()
+}
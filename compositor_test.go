@@ -0,0 +1,126 @@
+package teaspoon
+
+import (
+	"testing"
+)
+
+func TestCompose(t *testing.T) {
+	const marker = "\x1b[5z"
+
+	tests := []struct {
+		name   string
+		base   string
+		layers []Layer
+		want   string
+	}{
+		{
+			name: "no layers",
+			base: "abc\ndef",
+			want: "abc\ndef",
+		},
+		{
+			name:   "plain overlay",
+			base:   "hello",
+			layers: []Layer{{View: "XY", X: 1}},
+			want:   "hXYlo",
+		},
+		{
+			name:   "second line",
+			base:   "abc\ndef",
+			layers: []Layer{{View: "X", X: 2, Y: 1}},
+			want:   "abc\ndeX",
+		},
+		{
+			name:   "beyond the base",
+			base:   "a",
+			layers: []Layer{{View: "X", X: 2, Y: 2}},
+			want:   "a\n\n  X",
+		},
+		{
+			name:   "clipped left",
+			base:   "abc",
+			layers: []Layer{{View: "XYZ", X: -1}},
+			want:   "YZc",
+		},
+		{
+			name:   "clipped above",
+			base:   "abc\ndef",
+			layers: []Layer{{View: "X\nY", X: 1, Y: -1}},
+			want:   "aYc\ndef",
+		},
+		{
+			name:   "higher z drawn above",
+			base:   "abc",
+			layers: []Layer{{View: "x", X: 1, Z: 1}, {View: "y", X: 1}},
+			want:   "axc",
+		},
+		{
+			name:   "equal z drawn in order",
+			base:   "abc",
+			layers: []Layer{{View: "x", X: 1}, {View: "y", X: 1}},
+			want:   "ayc",
+		},
+		{
+			name:   "splitting a wide rune's first column",
+			base:   "日本語",
+			layers: []Layer{{View: "x", X: 1}},
+			want:   " x本語",
+		},
+		{
+			name:   "splitting a wide rune's second column",
+			base:   "日本語",
+			layers: []Layer{{View: "x", X: 2}},
+			want:   "日x 語",
+		},
+		{
+			name:   "wide rune over narrow runes",
+			base:   "abcd",
+			layers: []Layer{{View: "本", X: 1}},
+			want:   "a本d",
+		},
+		{
+			name:   "wide rune clipped left",
+			base:   "abcd",
+			layers: []Layer{{View: "本x", X: -1}},
+			want:   " xcd",
+		},
+		{
+			name:   "styled base",
+			base:   "\x1b[31mred\x1b[0m",
+			layers: []Layer{{View: "X", X: 1}},
+			want:   "\x1b[0m\x1b[31mr\x1b[0mX\x1b[0m\x1b[31md\x1b[0m",
+		},
+		{
+			name:   "styled layer",
+			base:   "ab\x1b[1mcd",
+			layers: []Layer{{View: "\x1b[4mX\x1b[0m", X: 1}},
+			want:   "a\x1b[0m\x1b[4mX\x1b[0m\x1b[1mcd\x1b[0m",
+		},
+		{
+			name:   "covered markers kept balanced",
+			base:   "a" + marker + "bc" + marker + "d",
+			layers: []Layer{{View: "XY", X: 1}},
+			want:   "a" + marker + "XY" + marker + "d",
+		},
+		{
+			name:   "layer markers travel with the layer",
+			base:   "abcd",
+			layers: []Layer{{View: marker + "X" + marker, X: 2}},
+			want:   "ab" + marker + "X" + marker + "d",
+		},
+		{
+			name:   "ragged layer padded",
+			base:   "abcd\nefgh",
+			layers: []Layer{{View: "XY\n" + marker + "Z" + marker, X: 1}},
+			want:   "aXYd\ne" + marker + "Z" + marker + " h",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := Compose(test.base, test.layers...); got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package teaspoon
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Cursor Shapes
+/*
+ - Pointer shape names sent to the terminal with OSC 22, using the CSS cursor names understood by most terminals
+ - An empty shape leaves the cursor to the surrounding elements
+*/
+type CursorShape string
+
+const (
+	DefaultCursor    CursorShape = "default"
+	PointerCursor    CursorShape = "pointer"
+	TextCursor       CursorShape = "text"
+	MoveCursor       CursorShape = "move"
+	GrabCursor       CursorShape = "grab"
+	GrabbingCursor   CursorShape = "grabbing"
+	EWResizeCursor   CursorShape = "ew-resize"
+	NSResizeCursor   CursorShape = "ns-resize"
+	NotAllowedCursor CursorShape = "not-allowed"
+)
+
+//* Drag Cursor Shape
+/*
+ - Returns the shape shown while the element is dragged, which is DragCursor if defined
+ - Elements showing GrabCursor switch to GrabbingCursor, others keep their Cursor
+*/
+func (i Interactable) dragCursor() CursorShape {
+	switch {
+	case i.DragCursor != "":
+		return i.DragCursor
+	case i.Cursor == GrabCursor:
+		return GrabbingCursor
+	}
+	return i.Cursor
+}
+
+//* Cursor Changed Message
+/*
+ - Returned by a controller's commands when the shape changes, so models may react before the next render
+*/
+type CursorChanged struct {
+	Shape CursorShape
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Cursor Controller
+/*
+ - Sets the terminal pointer shape from the hover, drag and drop state of a set of elements
+ - An invalid drop target under a drag shows NotAllowedCursor, then a dragged element's drag cursor, then a hovered element's Cursor
+ - The shape is written by Render as part of the model's view, never directly to the terminal, so the escape sequence
+   cannot interleave with the renderer's output
+ - Render must therefore wrap the outermost View, and nothing is written when Supported is false
+*/
+type CursorController struct {
+	Supported bool
+
+	current CursorShape
+}
+
+//* Creation Method
+/*
+ - Returns a controller, supported if the terminal is recognised
+*/
+func NewCursorController() *CursorController {
+	return &CursorController{
+		Supported: DetectCursorSupport(),
+	}
+}
+
+//* Cursor Support Detection
+/*
+ - Returns true for terminals known to implement OSC 22 pointer shapes, such as xterm, kitty, foot and WezTerm
+ - TEASPOON_CURSOR may be set to 1 or 0 to force support on or off
+*/
+func DetectCursorSupport() bool {
+	switch os.Getenv("TEASPOON_CURSOR") {
+	case "1":
+		return true
+	case "0":
+		return false
+	}
+
+	if program := os.Getenv("TERM_PROGRAM"); strings.EqualFold(program, "WezTerm") {
+		return true
+	}
+	term := os.Getenv("TERM")
+	for _, supported := range []string{"xterm-kitty", "foot", "wezterm"} {
+		if strings.HasPrefix(term, supported) {
+			return true
+		}
+	}
+	return os.Getenv("XTERM_VERSION") != ""
+}
+
+//* Current Shape
+/*
+ - Returns the shape drawn by Render
+*/
+func (c *CursorController) Current() CursorShape {
+	if c.current == "" {
+		return DefaultCursor
+	}
+	return c.current
+}
+
+//* Cursor Update
+/*
+ - Resolves the shape for the elements and returns a command setting it if it has changed
+*/
+func (c *CursorController) Update(elements ...Interactive) tea.Cmd {
+	return c.Set(ResolveCursor(elements...))
+}
+
+//* Set Cursor
+/*
+ - Sets the shape drawn by the next Render if it differs from the current shape, an empty shape restores the default
+ - Returns a command emitting CursorChanged when the shape changes
+*/
+func (c *CursorController) Set(shape CursorShape) tea.Cmd {
+	if shape == "" {
+		shape = DefaultCursor
+	}
+	if !c.Supported || shape == c.Current() {
+		return nil
+	}
+	c.current = shape
+
+	changedEvent := CursorChanged{Shape: shape}
+	return func() tea.Msg {
+		return changedEvent
+	}
+}
+
+//* Reset Cursor
+/*
+ - Restores the default shape, to be called before returning tea.Quit so the final frame resets the terminal
+*/
+func (c *CursorController) Reset() tea.Cmd {
+	return c.Set(DefaultCursor)
+}
+
+//* Render Cursor
+/*
+ - Prefixes the view with the escape sequence for the current shape, once a shape has been set
+ - The renderer only redraws changed lines, so the sequence is written again whenever the shape changes
+*/
+func (c *CursorController) Render(view string) string {
+	if !c.Supported || c.current == "" {
+		return view
+	}
+	return fmt.Sprintf("\x1b]22;%s\x1b\\", c.current) + view
+}
+
+//* Resolve Cursor
+/*
+ - Returns the shape the elements call for, or an empty shape if none of them do
+*/
+func ResolveCursor(elements ...Interactive) CursorShape {
+	var dragging, hovered CursorShape
+	for _, element := range elements {
+		interaction := element.GetInteraction()
+		switch {
+		case interaction.IsBelowDrop && !interaction.IsValidDrop:
+			return NotAllowedCursor
+		case interaction.IsDragging && dragging == "":
+			dragging = interaction.dragCursor()
+		case interaction.IsHovered && hovered == "":
+			hovered = interaction.Cursor
+		}
+	}
+	if dragging != "" {
+		return dragging
+	}
+	return hovered
+}
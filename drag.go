@@ -1,6 +1,8 @@
 package teaspoon
 
 import (
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -11,16 +13,24 @@ import (
  - Interface for handling drag events and defining local and external event behaviours
  - Default emission behaviour for an event will not occur if a custom behavior is defined
  - Default behaviours do not emit drag events unless EmitMessage is set to true
+ - DragType is attached to emitted events so drop targets can assess them against AcceptedDropTypes
+ - If Group is set, dragging a selected member drags every selected member, with Payload attached to each item
 */
 type DragHandler struct {
-	OnDragStart func(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd)
-	OnDragMove  func(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd)
-	OnDragEnd   func(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd)
+	OnDragStart  func(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd)
+	OnDragMove   func(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd)
+	OnDragEnd    func(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd)
+	OnDragCancel func(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd)
+
+	OnDragStartEvent  func(element Interactive, dragEvent DragEvent) (Interactive, tea.Cmd)
+	OnDragMoveEvent   func(element Interactive, dragEvent DragEvent) (Interactive, tea.Cmd)
+	OnDragEndEvent    func(element Interactive, dragEvent DragEvent) (Interactive, tea.Cmd)
+	OnDragCancelEvent func(element Interactive, dragEvent DragEvent) (Interactive, tea.Cmd)
 
-	OnDragStartEvent func(element Interactive, dragEvent DragEvent) (Interactive, tea.Cmd)
-	OnDragMoveEvent  func(element Interactive, dragEvent DragEvent) (Interactive, tea.Cmd)
-	OnDragEndEvent   func(element Interactive, dragEvent DragEvent) (Interactive, tea.Cmd)
+	Group   *SelectionGroup
+	Payload func(element Interactive) any
 
+	DragType     string
 	EmitMessages bool
 }
 
@@ -30,6 +40,7 @@ type DragHandler struct {
 /*
  - Drag event messages to enable responses to external interactions
  - Default behaviours will broadcast if EmitMessages is set to true
+ - Items holds every dragged element, which is the source alone unless a selection is dragged
 */
 type DragEvent struct {
 	ID         string
@@ -38,6 +49,7 @@ type DragEvent struct {
 	DragOrigin Point
 	DragOffset Point
 	MouseMsg   tea.MouseMsg
+	Items      []DragItem
 }
 
 //* Drag Event Types
@@ -50,8 +62,20 @@ const (
 	DragStart DragEventType = iota
 	DragMove
 	DragEnd
+	DragCancel
 )
 
+//* Drag Watchdog
+/*
+ - Scheduled message used to cancel drags which have not received motion or release within DragCancelTimeout
+ - One watchdog is armed per drag and re-arms itself for the remaining time while the drag stays active
+ - Only the element matching ID responds, and only to the watchdog armed at Time
+*/
+type DragWatchdog struct {
+	ID   string
+	Time time.Time
+}
+
 //?--------------------------------------------------------------------------------------------------------------------
 
 //* Point Type
@@ -72,6 +96,7 @@ type Draggable interface {
 	HandleDragStart(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd)
 	HandleDragMove(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd)
 	HandleDragEnd(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd)
+	HandleDragCancel(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd)
 }
 
 //?--------------------------------------------------------------------------------------------------------------------
@@ -80,7 +105,7 @@ type Draggable interface {
 /*
  - Responds to localized drag start events with OnDragStart function or DefaultDragStart if undefined
 */
-func (h *DragHandler) HandleDragStart(element Interactive, mouseMsg tea.MouseMsg, dragEvent DragEvent) (Interactive, tea.Cmd) {
+func (h *DragHandler) HandleDragStart(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
 	if h.OnDragStart != nil {
 		return h.OnDragStart(element, mouseMsg)
 	}
@@ -90,6 +115,7 @@ func (h *DragHandler) HandleDragStart(element Interactive, mouseMsg tea.MouseMsg
 //* Default Drag Start Behaviour
 /*
  - Sets an element's MouseInteraction IsDragging property to true
+ - The dragged items are collected once here and carried by every later event of the drag
 */
 func (h *DragHandler) DefaultDragStart(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
 	interaction := element.GetInteraction()
@@ -97,15 +123,18 @@ func (h *DragHandler) DefaultDragStart(element Interactive, mouseMsg tea.MouseMs
 	interaction.IsDragging = true
 	interaction.DragOrigin = Point{X: mouseMsg.X, Y: mouseMsg.Y}
 	interaction.DragOffset = Point{X: 0, Y: 0}
+	interaction.dragItems = h.DragItems(element)
 
 	if h.EmitMessages {
 		cmd := func() tea.Msg {
 			return DragEvent{
 				EventType:  DragStart,
 				ID:         interaction.ID,
+				DragType:   h.DragType,
 				MouseMsg:   mouseMsg,
 				DragOrigin: interaction.DragOrigin,
 				DragOffset: interaction.DragOffset,
+				Items:      interaction.dragItems,
 			}
 		}
 		return element, cmd
@@ -119,7 +148,7 @@ func (h *DragHandler) DefaultDragStart(element Interactive, mouseMsg tea.MouseMs
 /*
  - Responds to localized drag move events with OnDragMove function or DefaultDragMove if undefined
 */
-func (h *DragHandler) HandleDragMove(element Interactive, mouseMsg tea.MouseMsg, dragEvent DragEvent) (Interactive, tea.Cmd) {
+func (h *DragHandler) HandleDragMove(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
 	if h.OnDragMove != nil {
 		return h.OnDragMove(element, mouseMsg)
 	}
@@ -144,9 +173,11 @@ func (h *DragHandler) DefaultDragMove(element Interactive, mouseMsg tea.MouseMsg
 			return DragEvent{
 				EventType:  DragMove,
 				ID:         interaction.ID,
+				DragType:   h.DragType,
 				MouseMsg:   mouseMsg,
 				DragOrigin: interaction.DragOrigin,
 				DragOffset: interaction.DragOffset,
+				Items:      interaction.dragItems,
 			}
 		}
 		return element, cmd
@@ -160,7 +191,7 @@ func (h *DragHandler) DefaultDragMove(element Interactive, mouseMsg tea.MouseMsg
 /*
  - Responds to localized drag end events with OnDragEnd function or DefaultDragEnd if undefined
 */
-func (h *DragHandler) HandleDragEnd(element Interactive, mouseMsg tea.MouseMsg, dragEvent DragEvent) (Interactive, tea.Cmd) {
+func (h *DragHandler) HandleDragEnd(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
 	if h.OnDragEnd != nil {
 		return h.OnDragEnd(element, mouseMsg)
 	}
@@ -175,14 +206,19 @@ func (h *DragHandler) DefaultDragEnd(element Interactive, mouseMsg tea.MouseMsg)
 	interaction := element.GetInteraction()
 	interaction.IsDragging = false
 
+	items := interaction.dragItems
+	interaction.dragItems = nil
+
 	if h.EmitMessages {
 		cmd := func() tea.Msg {
 			return DragEvent{
 				EventType:  DragEnd,
 				ID:         interaction.ID,
+				DragType:   h.DragType,
 				MouseMsg:   mouseMsg,
 				DragOrigin: interaction.DragOrigin,
 				DragOffset: interaction.DragOffset,
+				Items:      items,
 			}
 		}
 		return element, cmd
@@ -192,6 +228,55 @@ func (h *DragHandler) DefaultDragEnd(element Interactive, mouseMsg tea.MouseMsg)
 
 //?--------------------------------------------------------------------------------------------------------------------
 
+//* Drag Cancel Handler
+/*
+ - Responds to localized drag cancellations with OnDragCancel function or DefaultDragCancel if undefined
+ - Cancellation occurs on Escape, focus loss, a lost release, or the DragCancelTimeout watchdog
+ - The mouse message reflects the last known pointer position of the drag
+*/
+func (h *DragHandler) HandleDragCancel(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
+	if h.OnDragCancel != nil {
+		return h.OnDragCancel(element, mouseMsg)
+	}
+	return h.DefaultDragCancel(element, mouseMsg)
+}
+
+//* Default Drag Cancel Behaviour
+/*
+ - Sets an element's MouseInteraction IsDragging, IsAboveDrop and IsValidDrop properties to false
+ - The emitted event carries the drag position at the time of cancellation before the offset is reset
+*/
+func (h *DragHandler) DefaultDragCancel(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
+	var cmd tea.Cmd
+
+	interaction := element.GetInteraction()
+	interaction.IsDragging = false
+	interaction.IsAboveDrop = false
+	interaction.IsValidDrop = false
+
+	if h.EmitMessages {
+		dragEvent := DragEvent{
+			EventType:  DragCancel,
+			ID:         interaction.ID,
+			DragType:   h.DragType,
+			MouseMsg:   mouseMsg,
+			DragOrigin: interaction.DragOrigin,
+			DragOffset: interaction.DragOffset,
+			Items:      interaction.dragItems,
+		}
+		cmd = func() tea.Msg {
+			return dragEvent
+		}
+	}
+
+	interaction.DragOffset = Point{X: 0, Y: 0}
+	interaction.dragItems = nil
+
+	return element, cmd
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
 //* Drag Event Aware Interface
 /*
  - Interface definition for handling localized hover interactions
@@ -200,6 +285,7 @@ type DragEventAware interface {
 	HandleDragStartEvent(element Interactive, dragEvent DragEvent) (Interactive, tea.Cmd)
 	HandleDragMoveEvent(element Interactive, dragEvent DragEvent) (Interactive, tea.Cmd)
 	HandleDragEndEvent(element Interactive, dragEvent DragEvent) (Interactive, tea.Cmd)
+	HandleDragCancelEvent(element Interactive, dragEvent DragEvent) (Interactive, tea.Cmd)
 }
 
 //?--------------------------------------------------------------------------------------------------------------------
@@ -243,3 +329,17 @@ func (h *DragHandler) HandleDragEndEvent(element Interactive, dragEvent DragEven
 	}
 	return element, nil
 }
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* External Drag Cancel Handler
+/*
+ - Responds to external drag cancel events with OnDragCancelEvent function if defined
+ - No default behaviour is defined for responding to external drag cancel events
+*/
+func (h *DragHandler) HandleDragCancelEvent(element Interactive, dragEvent DragEvent) (Interactive, tea.Cmd) {
+	if h.OnDragCancelEvent != nil {
+		return h.OnDragCancelEvent(element, dragEvent)
+	}
+	return element, nil
+}
@@ -0,0 +1,47 @@
+package teaspoon
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+func TestDragWatchdog(t *testing.T) {
+	zone.NewGlobal()
+	element := newTestElement("a")
+	i := element.interaction
+	i.Drag = &DragHandler{}
+	i.DragCancelTimeout = time.Nanosecond
+	i.IsInside = func(Interactive, tea.Msg) bool { return true }
+	i.ExternalHandler = func(element Interactive, msg tea.Msg) (Interactive, tea.Cmd) {
+		return element, nil
+	}
+
+	router := NewEventRouter(element)
+	subscribed := 0
+	router.SubscribeFunc(Topic{}, func(msg tea.Msg) tea.Cmd {
+		subscribed++
+		return nil
+	})
+
+	i.HandleMouseMsg(element, tea.MouseMsg{X: 1, Y: 1, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	if !i.IsDragging {
+		t.Fatal("press did not start a drag")
+	}
+	armed := i.watchdogAt
+	time.Sleep(time.Millisecond)
+
+	router.Route(DragWatchdog{ID: "a", Time: armed.Add(-time.Second)})
+	if !i.IsDragging {
+		t.Error("stale watchdog cancelled the drag")
+	}
+	router.Route(DragWatchdog{ID: "a", Time: armed})
+	if i.IsDragging {
+		t.Error("expired watchdog left the drag active")
+	}
+	if subscribed != 0 {
+		t.Errorf("watchdog delivered to %d subscribers", subscribed)
+	}
+}
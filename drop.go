@@ -11,6 +11,7 @@ import (
  - Interface for handling drop events and defining local and external event behaviours
  - Default emission behaviour for an event will not occur if a custom behavior is defined
  - Default behaviours do not emit hover events unless EmitMessage is set to true
+ - IsItemAcceptable assesses each dragged item of a group drag of an accepted type, so a drop may accept some items and deny others
 */
 type DropHandler struct {
 	OnDropEnter   func(element Interactive, dragEvent DragEvent) (Interactive, tea.Cmd)
@@ -21,6 +22,8 @@ type DropHandler struct {
 	OnDropDeny    func(element Interactive, dragEvent DragEvent) (Interactive, tea.Cmd)
 	IsAcceptable  func(element Interactive, dragEvent DragEvent) bool
 
+	IsItemAcceptable func(element Interactive, dragEvent DragEvent, item DragItem) bool
+
 	OnDropEnterEvent   func(element Interactive, dropEvent DropEvent) (Interactive, tea.Cmd)
 	OnDropHoverEvent   func(element Interactive, dropEvent DropEvent) (Interactive, tea.Cmd)
 	OnDropLeaveEvent   func(element Interactive, dropEvent DropEvent) (Interactive, tea.Cmd)
@@ -38,6 +41,7 @@ type DropHandler struct {
 /*
  - Drop event messages to enable responses to external interactions
  - Default behaviours will broadcast if EmitMessages is set to true
+ - Release, accept and deny events list the IDs of the accepted and denied items, a partial drop has both
 */
 type DropEvent struct {
 	ID         string
@@ -45,6 +49,8 @@ type DropEvent struct {
 	DropType   string
 	Acceptable bool
 	DragEvent  DragEvent
+	Accepted   []string
+	Denied     []string
 }
 
 //* Drop Event Types
@@ -95,8 +101,24 @@ func (h *DropHandler) HandleIsAcceptable(element Interactive, dragEvent DragEven
 //* Default Acceptable Drop Assessment
 /*
  - Returns true if the DragType is found within the accepted drop types list
+ - If IsItemAcceptable is defined a drop of an accepted type is further limited to those with an acceptable item
 */
 func (h *DropHandler) DefaultIsAcceptable(element Interactive, dragEvent DragEvent) bool {
+	if !h.acceptsType(dragEvent) {
+		return false
+	}
+	if h.IsItemAcceptable == nil {
+		return true
+	}
+	for _, item := range dragEvent.DraggedItems() {
+		if h.IsItemAcceptable(element, dragEvent, item) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *DropHandler) acceptsType(dragEvent DragEvent) bool {
 	for _, dropType := range h.AcceptedDropTypes {
 		if dragEvent.DragType == dropType {
 			return true
@@ -105,6 +127,33 @@ func (h *DropHandler) DefaultIsAcceptable(element Interactive, dragEvent DragEve
 	return false
 }
 
+//* Acceptable Item Assessment Handler
+/*
+ - Responds to per item drop assessments with the whole drop's acceptability, further limited by IsItemAcceptable if defined
+ - Items are judged by the same HandleIsAcceptable used on enter and hover, so an acceptable drop is never denied outright
+*/
+func (h *DropHandler) HandleIsItemAcceptable(element Interactive, dragEvent DragEvent, item DragItem) bool {
+	return h.HandleIsAcceptable(element, dragEvent) && h.itemAcceptable(element, dragEvent, item)
+}
+
+func (h *DropHandler) itemAcceptable(element Interactive, dragEvent DragEvent, item DragItem) bool {
+	return h.IsItemAcceptable == nil || h.IsItemAcceptable(element, dragEvent, item)
+}
+
+//* Item Acceptance
+/*
+ - Returns a copy of the drag event with every item's Accepted property assessed
+*/
+func (h *DropHandler) assessItems(element Interactive, dragEvent DragEvent) DragEvent {
+	acceptable := h.HandleIsAcceptable(element, dragEvent)
+	items := append([]DragItem{}, dragEvent.DraggedItems()...)
+	for i := range items {
+		items[i].Accepted = acceptable && h.itemAcceptable(element, dragEvent, items[i])
+	}
+	dragEvent.Items = items
+	return dragEvent
+}
+
 //?--------------------------------------------------------------------------------------------------------------------
 
 //* Drop Enter Handler
@@ -233,24 +282,29 @@ func (h *DropHandler) HandleDropRelease(element Interactive, dragEvent DragEvent
 
 //* Default Drop Release
 /*
- - The element's MouseInteraction IsValidDrop is determined
- - Calls relevant accept or deny drop handler method
+ - Each dragged item is assessed and the element's MouseInteraction IsValidDrop is true if any were accepted
+ - Calls relevant accept or deny drop handler method with the assessed items, so a partial drop is accepted
 */
 func (h *DropHandler) DefaultDropRelease(element Interactive, dragEvent DragEvent) (Interactive, tea.Cmd) {
 	var cmd tea.Cmd
 	var cmds []tea.Cmd
 
+	dragEvent = h.assessItems(element, dragEvent)
+
 	interaction := element.GetInteraction()
-	interaction.IsValidDrop = h.HandleIsAcceptable(element, dragEvent)
+	interaction.IsValidDrop = len(dragEvent.AcceptedIDs()) > 0
 
 	if h.EmitMessages {
+		dropEvent := DropEvent{
+			EventType:  DropRelease,
+			ID:         interaction.ID,
+			Acceptable: interaction.IsValidDrop,
+			DragEvent:  dragEvent,
+			Accepted:   dragEvent.AcceptedIDs(),
+			Denied:     dragEvent.DeniedIDs(),
+		}
 		cmds = append(cmds, func() tea.Msg {
-			return DropEvent{
-				EventType:  DropRelease,
-				ID:         interaction.ID,
-				Acceptable: interaction.IsValidDrop,
-				DragEvent:  dragEvent,
-			}
+			return dropEvent
 		})
 	}
 
@@ -292,6 +346,8 @@ func (h *DropHandler) DefaultDropAccept(element Interactive, dragEvent DragEvent
 				ID:         interaction.ID,
 				Acceptable: true,
 				DragEvent:  dragEvent,
+				Accepted:   dragEvent.AcceptedIDs(),
+				Denied:     dragEvent.DeniedIDs(),
 			}
 		}
 	}
@@ -331,6 +387,8 @@ func (h *DropHandler) DefaultDropDeny(element Interactive, dragEvent DragEvent)
 				ID:         interaction.ID,
 				Acceptable: false,
 				DragEvent:  dragEvent,
+				Accepted:   dragEvent.AcceptedIDs(),
+				Denied:     dragEvent.DeniedIDs(),
 			}
 		}
 	}
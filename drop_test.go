@@ -0,0 +1,79 @@
+package teaspoon
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDropItemAcceptance(t *testing.T) {
+	dragEvent := DragEvent{
+		ID:       "a",
+		DragType: "card",
+		Items:    []DragItem{{ID: "a"}, {ID: "b"}, {ID: "locked"}},
+	}
+	unlocked := func(element Interactive, dragEvent DragEvent, item DragItem) bool {
+		return !strings.HasPrefix(item.ID, "locked")
+	}
+
+	tests := []struct {
+		name       string
+		drop       *DropHandler
+		acceptable bool
+		accepted   []string
+	}{
+		{
+			name:       "accepted type",
+			drop:       &DropHandler{AcceptedDropTypes: []string{"card"}},
+			acceptable: true,
+			accepted:   []string{"a", "b", "locked"},
+		},
+		{
+			name: "rejected type",
+			drop: &DropHandler{AcceptedDropTypes: []string{"file"}, IsItemAcceptable: unlocked},
+		},
+		{
+			name:       "accepted type with item predicate",
+			drop:       &DropHandler{AcceptedDropTypes: []string{"card"}, IsItemAcceptable: unlocked},
+			acceptable: true,
+			accepted:   []string{"a", "b"},
+		},
+		{
+			name: "custom acceptance without types",
+			drop: &DropHandler{
+				IsAcceptable:     func(element Interactive, dragEvent DragEvent) bool { return true },
+				IsItemAcceptable: unlocked,
+			},
+			acceptable: true,
+			accepted:   []string{"a", "b"},
+		},
+		{
+			name: "custom rejection",
+			drop: &DropHandler{
+				AcceptedDropTypes: []string{"card"},
+				IsAcceptable:      func(element Interactive, dragEvent DragEvent) bool { return false },
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			element := newTestElement("target")
+			if acceptable := test.drop.HandleIsAcceptable(element, dragEvent); acceptable != test.acceptable {
+				t.Errorf("acceptable %v, want %v", acceptable, test.acceptable)
+			}
+			var accepted []string
+			for _, item := range dragEvent.Items {
+				if test.drop.HandleIsItemAcceptable(element, dragEvent, item) {
+					accepted = append(accepted, item.ID)
+				}
+			}
+			if !slices.Equal(accepted, test.accepted) {
+				t.Errorf("items %v accepted, want %v", accepted, test.accepted)
+			}
+			if assessed := test.drop.assessItems(element, dragEvent).AcceptedIDs(); !slices.Equal(assessed, test.accepted) {
+				t.Errorf("items %v assessed as accepted, want %v", assessed, test.accepted)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package teaspoon
+
+type testElement struct {
+	interaction *Interactable
+}
+
+func (e *testElement) GetInteraction() *Interactable {
+	return e.interaction
+}
+
+func newTestElement(id string) *testElement {
+	return &testElement{interaction: &Interactable{
+		ID:    id,
+		Hover: &HoverHandler{},
+		Click: &ClickHandler{},
+	}}
+}
+
+func elementIDs(elements []Interactive) []string {
+	ids := make([]string, len(elements))
+	for i, element := range elements {
+		ids[i] = element.GetInteraction().ID
+	}
+	return ids
+}
@@ -26,6 +26,7 @@ type InteractiveButton struct {
  - A ZoneID is defined so mouse events can be attributed to it
  - Click and Hover Handlers are instantiated
  - A custom OnClick method is defined which toggles the state of IsSelected
+ - Every left press is a click, so both presses of a double click toggle, and right clicks leave the button unchanged
 */
 func NewButton(label string) InteractiveButton {
 	return InteractiveButton{
@@ -0,0 +1,34 @@
+package teaspoon
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Forward Message
+/*
+ - Passes the message to every element, mouse messages through HandleMouseMsg and others through HandleExternalEvent
+ - For components owning draggable elements, so drags continue and cancel outside of the element's own bounds
+ - Each element is replaced by the one its handler returns when it is still a T, so value type elements keep their state
+*/
+func Forward[T Interactive](elements []T, msg tea.Msg) tea.Cmd {
+	var cmds []tea.Cmd
+	mouseMsg, isMouse := msg.(tea.MouseMsg)
+	for i, element := range elements {
+		var updated Interactive
+		var cmd tea.Cmd
+		if isMouse {
+			updated, cmd = element.GetInteraction().HandleMouseMsg(element, mouseMsg)
+		} else {
+			updated, cmd = element.GetInteraction().HandleExternalEvent(element, msg)
+		}
+		if updated, ok := updated.(T); ok {
+			elements[i] = updated
+		}
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	return tea.Batch(cmds...)
+}
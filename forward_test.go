@@ -0,0 +1,34 @@
+package teaspoon
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type valueElement struct {
+	received    int
+	interaction *Interactable
+}
+
+func (v valueElement) GetInteraction() *Interactable {
+	return v.interaction
+}
+
+func TestForward(t *testing.T) {
+	count := func(element Interactive, msg tea.Msg) (Interactive, tea.Cmd) {
+		v := element.(valueElement)
+		v.received++
+		return v, nil
+	}
+	elements := []valueElement{
+		{interaction: &Interactable{ID: "a", LocalHandler: count, ExternalHandler: count}},
+		{interaction: &Interactable{ID: "b", ExternalHandler: count}},
+	}
+
+	Forward(elements, tea.MouseMsg{X: 1, Y: 1, Action: tea.MouseActionMotion})
+	Forward(elements, tea.KeyMsg{Type: tea.KeyEsc})
+	if elements[0].received != 2 || elements[1].received != 1 {
+		t.Errorf("elements received %d and %d messages, want 2 and 1", elements[0].received, elements[1].received)
+	}
+}
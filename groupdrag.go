@@ -0,0 +1,127 @@
+package teaspoon
+
+import (
+	zone "github.com/lrstanley/bubblezone"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Drag Item
+/*
+ - One element carried by a drag, with the payload given by the DragHandler's Payload function
+ - Origin is the top left cell of the element when the drag started, used to position its ghost
+ - Accepted is assessed per item by the drop target before its release, accept and deny handlers are called
+*/
+type DragItem struct {
+	ID       string
+	Payload  any
+	Origin   Point
+	Accepted bool
+}
+
+//* Drag Items Collection
+/*
+ - Returns the items dragged with the element, for use by custom drag start behaviours
+ - If the element is a selected member of Group every selected member is returned in group order, otherwise only the element
+*/
+func (h *DragHandler) DragItems(element Interactive) []DragItem {
+	members := []Interactive{element}
+	if h.Group != nil && element.GetInteraction().IsSelected {
+		selected := h.Group.Selected()
+		for _, member := range selected {
+			if member.GetInteraction().ID == element.GetInteraction().ID {
+				members = selected
+				break
+			}
+		}
+	}
+
+	items := make([]DragItem, 0, len(members))
+	for _, member := range members {
+		id := member.GetInteraction().ID
+		item := DragItem{ID: id}
+		if h.Payload != nil {
+			item.Payload = h.Payload(member)
+		}
+		if info := zone.Get(id); !info.IsZero() {
+			item.Origin = Point{X: info.StartX, Y: info.StartY}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Dragged Items
+/*
+ - Returns the event's Items, or a single item for the source if the event was built without any
+*/
+func (e DragEvent) DraggedItems() []DragItem {
+	if len(e.Items) > 0 {
+		return e.Items
+	}
+	return []DragItem{{ID: e.ID, Origin: e.DragOrigin}}
+}
+
+//* Dragged IDs
+/*
+ - Returns the IDs of every dragged item in order
+*/
+func (e DragEvent) DraggedIDs() []string {
+	var ids []string
+	for _, item := range e.DraggedItems() {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+//* Accepted IDs
+/*
+ - Returns the IDs of the items a drop target accepted, which is assessed before its release handlers are called
+*/
+func (e DragEvent) AcceptedIDs() []string {
+	var ids []string
+	for _, item := range e.DraggedItems() {
+		if item.Accepted {
+			ids = append(ids, item.ID)
+		}
+	}
+	return ids
+}
+
+//* Denied IDs
+/*
+ - Returns the IDs of the items a drop target denied
+*/
+func (e DragEvent) DeniedIDs() []string {
+	var ids []string
+	for _, item := range e.DraggedItems() {
+		if !item.Accepted {
+			ids = append(ids, item.ID)
+		}
+	}
+	return ids
+}
+
+//* Drag Ghosts
+/*
+ - Returns a layer for each dragged item at its origin moved by the drag offset, ready for Compose
+ - The render function draws the ghost of an item, and items it renders as an empty string are skipped
+*/
+func (e DragEvent) Ghosts(z int, render func(item DragItem) string) []Layer {
+	var layers []Layer
+	for _, item := range e.DraggedItems() {
+		view := render(item)
+		if view == "" {
+			continue
+		}
+		layers = append(layers, Layer{
+			View: view,
+			X:    item.Origin.X + e.DragOffset.X,
+			Y:    item.Origin.Y + e.DragOffset.Y,
+			Z:    z,
+		})
+	}
+	return layers
+}
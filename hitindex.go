@@ -0,0 +1,205 @@
+package teaspoon
+
+import (
+	zone "github.com/lrstanley/bubblezone"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Default Hit Index Cell Size
+/*
+ - Width and height in terminal cells of each grid bucket if CellSize is undefined
+*/
+const DefaultHitIndexCellSize = 8
+
+//* Hit Index
+/*
+ - Uniform grid over zone bounds resolving a pointer position to the IDs beneath it without testing every zone
+ - Invalidate should be called whenever the view is rendered, the index then refreshes on its next lookup
+ - Invalidating specific IDs limits the refresh to those zones, for views which only re-render part of the layout
+ - Refreshing only moves the IDs whose bounds have changed between buckets
+ - Lookups are deferred until after rendering because zone.Scan stores bounds asynchronously
+ - CellSize must be set before the first refresh
+*/
+type HitIndex struct {
+	CellSize int
+
+	bounds map[string]hitBounds
+	grid   map[Point][]string
+	dirty  bool
+	stale  map[string]bool
+}
+
+type hitBounds struct {
+	startX, startY, endX, endY int
+}
+
+//* Creation Method
+/*
+ - Returns a new hit index tracking the given zone IDs
+*/
+func NewHitIndex(ids ...string) *HitIndex {
+	h := &HitIndex{
+		bounds: map[string]hitBounds{},
+		grid:   map[Point][]string{},
+		stale:  map[string]bool{},
+	}
+	h.Track(ids...)
+	return h
+}
+
+//* Track Zones
+/*
+ - Adds zone IDs to the index, which are bucketed on the next refresh
+*/
+func (h *HitIndex) Track(ids ...string) {
+	for _, id := range ids {
+		if _, exists := h.bounds[id]; !exists {
+			h.bounds[id] = hitBounds{startX: -1, endX: -2}
+			h.stale[id] = true
+		}
+	}
+}
+
+//* Untrack Zone
+/*
+ - Removes a zone ID from the index
+*/
+func (h *HitIndex) Untrack(id string) {
+	if bounds, exists := h.bounds[id]; exists {
+		h.unbucket(id, bounds)
+		delete(h.bounds, id)
+	}
+	delete(h.stale, id)
+}
+
+//* Invalidate Index
+/*
+ - Marks the given zones for refresh on the next lookup, or every tracked zone if no IDs are given
+ - To be called after the view changes
+*/
+func (h *HitIndex) Invalidate(ids ...string) {
+	if len(ids) == 0 {
+		h.dirty = true
+		return
+	}
+	for _, id := range ids {
+		if _, exists := h.bounds[id]; exists {
+			h.stale[id] = true
+		}
+	}
+}
+
+//* Refresh Index
+/*
+ - Reads the current bounds of the invalidated zones and rebuckets those which have moved
+*/
+func (h *HitIndex) Refresh() {
+	if h.dirty {
+		for id := range h.bounds {
+			h.refresh(id)
+		}
+	} else {
+		for id := range h.stale {
+			h.refresh(id)
+		}
+	}
+	h.dirty = false
+	h.stale = map[string]bool{}
+}
+
+func (h *HitIndex) refresh(id string) {
+	previous := h.bounds[id]
+	current := hitBounds{startX: -1, endX: -2}
+	if info := zone.Get(id); !info.IsZero() && info.StartX <= info.EndX && info.StartY <= info.EndY {
+		current = hitBounds{startX: info.StartX, startY: info.StartY, endX: info.EndX, endY: info.EndY}
+	}
+	if current == previous {
+		return
+	}
+	h.unbucket(id, previous)
+	h.bucket(id, current)
+	h.bounds[id] = current
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Hit Lookup
+/*
+ - Returns the IDs of the tracked zones containing the cell, refreshing the index first if it is invalid
+*/
+func (h *HitIndex) At(x, y int) []string {
+	if h.dirty || len(h.stale) > 0 {
+		h.Refresh()
+	}
+
+	var ids []string
+	for _, id := range h.grid[h.cell(x, y)] {
+		if bounds := h.bounds[id]; x >= bounds.startX && x <= bounds.endX && y >= bounds.startY && y <= bounds.endY {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+//* Hit Assessment
+/*
+ - Returns true if the tracked zone with the given ID contains the cell
+*/
+func (h *HitIndex) Contains(id string, x, y int) bool {
+	for _, hit := range h.At(x, y) {
+		if hit == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *HitIndex) cellSize() int {
+	if h.CellSize <= 0 {
+		return DefaultHitIndexCellSize
+	}
+	return h.CellSize
+}
+
+func (h *HitIndex) cell(x, y int) Point {
+	size := h.cellSize()
+	return Point{X: x / size, Y: y / size}
+}
+
+func (h *HitIndex) bucket(id string, bounds hitBounds) {
+	if bounds.startX > bounds.endX {
+		return
+	}
+	from, to := h.cell(bounds.startX, bounds.startY), h.cell(bounds.endX, bounds.endY)
+	for y := from.Y; y <= to.Y; y++ {
+		for x := from.X; x <= to.X; x++ {
+			cell := Point{X: x, Y: y}
+			h.grid[cell] = append(h.grid[cell], id)
+		}
+	}
+}
+
+func (h *HitIndex) unbucket(id string, bounds hitBounds) {
+	if bounds.startX > bounds.endX {
+		return
+	}
+	from, to := h.cell(bounds.startX, bounds.startY), h.cell(bounds.endX, bounds.endY)
+	for y := from.Y; y <= to.Y; y++ {
+		for x := from.X; x <= to.X; x++ {
+			cell := Point{X: x, Y: y}
+			ids := h.grid[cell]
+			for i, bucketed := range ids {
+				if bucketed == id {
+					ids = append(ids[:i], ids[i+1:]...)
+					break
+				}
+			}
+			if len(ids) == 0 {
+				delete(h.grid, cell)
+			} else {
+				h.grid[cell] = ids
+			}
+		}
+	}
+}
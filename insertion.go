@@ -0,0 +1,58 @@
+package teaspoon
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Axes
+/*
+ - Enum selecting the direction along which elements are laid out
+*/
+type Axis int
+
+const (
+	XAxis Axis = iota
+	YAxis
+)
+
+//* Insertion Index
+/*
+ - Returns the index of the gap under the pointer among elements laid out in order along the axis
+ - Elements are split in half by their bounds, and a pointer on the exact middle resolves in the direction of travel
+   from the dragged index, or before the element if from is -1 for drags from elsewhere
+ - Indices are reported from start, so a visible slice of a longer sequence resolves to indices of the sequence
+ - Elements without bounds are skipped, and a pointer beyond every element returns the index after the last
+*/
+func InsertionIndex(axis Axis, mouseMsg tea.MouseMsg, from, start int, elements ...Interactive) int {
+	position := mouseMsg.Y
+	if axis == XAxis {
+		position = mouseMsg.X
+	}
+
+	for i, element := range elements {
+		bounds, ok := element.GetInteraction().HandleBounds(element)
+		if !ok {
+			continue
+		}
+		low, high := bounds.Min.Y, bounds.Max.Y
+		if axis == XAxis {
+			low, high = bounds.Min.X, bounds.Max.X
+		}
+
+		index := start + i
+		if position < low {
+			return index
+		}
+		if position > high {
+			continue
+		}
+		half := 2*(position-low) - (high - low)
+		if half < 0 || (half == 0 && (from < 0 || index <= from)) {
+			return index
+		}
+		return index + 1
+	}
+	return start + len(elements)
+}
@@ -0,0 +1,69 @@
+package teaspoon
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func boundedElement(id string, bounds Rect, ok bool) *testElement {
+	element := newTestElement(id)
+	element.interaction.Bounds = func(Interactive) (Rect, bool) {
+		return bounds, ok
+	}
+	return element
+}
+
+func TestInsertionIndex(t *testing.T) {
+	row := func(missing int) []Interactive {
+		elements := make([]Interactive, 3)
+		for i := range elements {
+			bounds := Rect{Min: Point{X: i * 5, Y: 0}, Max: Point{X: i*5 + 4, Y: 0}}
+			elements[i] = boundedElement(string(rune('a'+i)), bounds, i != missing)
+		}
+		return elements
+	}
+	column := func() []Interactive {
+		elements := make([]Interactive, 3)
+		for i := range elements {
+			bounds := Rect{Min: Point{X: 0, Y: i * 2}, Max: Point{X: 9, Y: i*2 + 1}}
+			elements[i] = boundedElement(string(rune('a'+i)), bounds, true)
+		}
+		return elements
+	}
+
+	tests := []struct {
+		name     string
+		axis     Axis
+		x, y     int
+		from     int
+		start    int
+		elements []Interactive
+		want     int
+	}{
+		{name: "before first", axis: XAxis, x: -1, from: -1, elements: row(-1), want: 0},
+		{name: "first half", axis: XAxis, x: 1, from: -1, elements: row(-1), want: 0},
+		{name: "second half", axis: XAxis, x: 3, from: -1, elements: row(-1), want: 1},
+		{name: "middle from elsewhere", axis: XAxis, x: 7, from: -1, elements: row(-1), want: 1},
+		{name: "middle moving right", axis: XAxis, x: 7, from: 0, elements: row(-1), want: 2},
+		{name: "middle moving left", axis: XAxis, x: 7, from: 2, elements: row(-1), want: 1},
+		{name: "middle of itself", axis: XAxis, x: 7, from: 1, elements: row(-1), want: 1},
+		{name: "beyond last", axis: XAxis, x: 20, from: -1, elements: row(-1), want: 3},
+		{name: "offset start", axis: XAxis, x: 3, from: -1, start: 5, elements: row(-1), want: 6},
+		{name: "offset beyond last", axis: XAxis, x: 20, from: -1, start: 5, elements: row(-1), want: 8},
+		{name: "without bounds", axis: XAxis, x: 7, from: -1, elements: row(1), want: 2},
+		{name: "no elements", axis: XAxis, x: 7, from: -1, start: 4, want: 4},
+		{name: "vertical top", axis: YAxis, x: 50, y: 2, from: -1, elements: column(), want: 1},
+		{name: "vertical bottom", axis: YAxis, x: 50, y: 3, from: -1, elements: column(), want: 2},
+		{name: "vertical beyond", axis: YAxis, y: 9, from: -1, elements: column(), want: 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mouseMsg := tea.MouseMsg{X: test.x, Y: test.y, Action: tea.MouseActionMotion}
+			if got := InsertionIndex(test.axis, mouseMsg, test.from, test.start, test.elements...); got != test.want {
+				t.Errorf("got %d, want %d", got, test.want)
+			}
+		})
+	}
+}
@@ -19,9 +19,39 @@ type Interactive interface {
 
 //?--------------------------------------------------------------------------------------------------------------------
 
+//* Default Double Click Threshold
+/*
+ - Maximum interval between presses for them to be treated as a double click if DoubleClickThreshold is undefined
+*/
+const DefaultDoubleClickThreshold = 500 * time.Millisecond
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Interaction Capabilities
+/*
+ - Bit flags naming the handlers an element may have enabled or disabled
+ - Flags may be combined to toggle several capabilities at once
+*/
+type Capability int
+
+const (
+	ClickCapability Capability = 1 << iota
+	HoverCapability
+	DragCapability
+	DropCapability
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
 //* Mouse Interaction
 /*
  -  Defines and handles mouse interaction of and between elements.
+ - IsDisabled suspends every capability, except hover when HoverWhileDisabled is true
+ - Individual capabilities are toggled with SetEnabled and are all enabled by default
+ - MotionInterval coalesces hover and drag move handling, FrameInterval limits it to once per frame
+ - Cursor and DragCursor name the pointer shapes shown by a CursorController while hovered and dragged
+ - Middleware wraps both the local and external handlers after any GlobalMiddleware
+ - SafeDispatch recovers panics and invalid results from handlers, also enabled for all elements by GlobalSafeDispatch
 */
 type Interactable struct {
 	ID string
@@ -37,6 +67,24 @@ type Interactable struct {
 	IsValidDrop          bool
 	IsAboveDrop          bool
 	IsBelowDrop          bool
+	DragCancelTimeout    time.Duration
+	LastDragActivity     time.Time
+	IsDisabled           bool
+	HoverWhileDisabled   bool
+	MotionInterval       time.Duration
+	CoalescedMotions     int
+	Cursor               CursorShape
+	DragCursor           CursorShape
+
+	disabled      Capability
+	lastMotion    Point
+	lastMotionAt  time.Time
+	pendingMotion *tea.MouseMsg
+	pendingAt     time.Time
+	pendingCount  int
+	watchdogAt    time.Time
+	dragItems     []DragItem
+	registry      *Registry
 
 	Click Clickable
 	Hover Hoverable
@@ -49,12 +97,94 @@ type Interactable struct {
 	DropEvent  DropEventAware
 
 	IsInside        func(element Interactive, mouseMsg tea.Msg) bool
+	Bounds          func(element Interactive) (Rect, bool)
 	LocalHandler    func(element Interactive, msg tea.Msg) (Interactive, tea.Cmd)
 	ExternalHandler func(element Interactive, msg tea.Msg) (Interactive, tea.Cmd)
+	Middleware      []Middleware
+	SafeDispatch    bool
 }
 
 //?--------------------------------------------------------------------------------------------------------------------
 
+//* Default Bounds Assessment
+/*
+ - Tests the element's zone, treating cells covered by a higher layer of its Registry as outside
+*/
 func (i Interactable) DefaultIsInside(element Interactive, mouseMsg tea.MouseMsg) bool {
+	if i.registry != nil && i.registry.IsOccluded(i.ID, mouseMsg.X, mouseMsg.Y) {
+		return false
+	}
 	return zone.Get(i.ID).InBounds(mouseMsg)
 }
+
+//* Bounds Assessment
+/*
+ - Determines whether a mouse message is within the element using IsInside or DefaultIsInside if undefined
+*/
+func (i *Interactable) HandleIsInside(element Interactive, mouseMsg tea.MouseMsg) bool {
+	if i.IsInside != nil {
+		return i.IsInside(element, mouseMsg)
+	}
+	return i.DefaultIsInside(element, mouseMsg)
+}
+
+//* Default Bounds
+/*
+ - Returns the cells covered by the element's zone, the same bounds tested by DefaultIsInside
+ - Reports false if the zone has not been rendered
+*/
+func (i Interactable) DefaultBounds(element Interactive) (Rect, bool) {
+	info := zone.Get(i.ID)
+	if info.IsZero() {
+		return Rect{}, false
+	}
+	return Rect{Min: Point{X: info.StartX, Y: info.StartY}, Max: Point{X: info.EndX, Y: info.EndY}}, true
+}
+
+//* Bounds Handler
+/*
+ - Returns the cells covered by the element using Bounds or DefaultBounds if undefined
+*/
+func (i *Interactable) HandleBounds(element Interactive) (Rect, bool) {
+	if i.Bounds != nil {
+		return i.Bounds(element)
+	}
+	return i.DefaultBounds(element)
+}
+
+//* Drag Position
+/*
+ - Returns a motion message at the last known pointer position of the current drag
+*/
+func (i Interactable) DragPosition() tea.MouseMsg {
+	return tea.MouseMsg{
+		X:      i.DragOrigin.X + i.DragOffset.X,
+		Y:      i.DragOrigin.Y + i.DragOffset.Y,
+		Action: tea.MouseActionMotion,
+		Button: tea.MouseButtonNone,
+	}
+}
+
+//* Capability Toggling
+/*
+ - Enables or disables one or more capabilities independently of IsDisabled
+*/
+func (i *Interactable) SetEnabled(capability Capability, enabled bool) {
+	if enabled {
+		i.disabled &^= capability
+	} else {
+		i.disabled |= capability
+	}
+}
+
+//* Capability Assessment
+/*
+ - Returns true if every given capability is enabled and not suspended by IsDisabled
+ - Hover remains enabled while disabled if HoverWhileDisabled is true
+*/
+func (i Interactable) IsEnabled(capability Capability) bool {
+	if i.disabled&capability != 0 {
+		return false
+	}
+	return !i.IsDisabled || (capability == HoverCapability && i.HoverWhileDisabled)
+}
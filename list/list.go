@@ -0,0 +1,439 @@
+package list
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jordanella/teaspoon"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* List Item Interface
+/*
+ - Interface definition for rows owned by a reorderable list
+ - Rows are marked with their Interactable ID by the list, item views should not mark themselves with it
+*/
+type Item interface {
+	teaspoon.Interactive
+	View() string
+}
+
+//* Reorder Event
+/*
+ - Emitted when an item has been moved by dragging or keyboard reordering
+ - From and To are the item's index before and after the move
+*/
+type ReorderEvent struct {
+	ID   string
+	From int
+	To   int
+}
+
+//* Auto Scroll Message
+/*
+ - Scheduled while an item is dragged near the edge of the list
+*/
+type autoScrollMsg struct {
+	ID string
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* List Styles
+/*
+ - Styles applied to rows by the list when rendering
+*/
+type Styles struct {
+	Row       lipgloss.Style
+	Cursor    lipgloss.Style
+	Dragging  lipgloss.Style
+	Indicator lipgloss.Style
+}
+
+//* Default List Styles
+/*
+ - Returns a basic set of styles highlighting the cursor, the dragged row and the insertion indicator
+*/
+func DefaultStyles() Styles {
+	return Styles{
+		Row:       lipgloss.NewStyle(),
+		Cursor:    lipgloss.NewStyle().Bold(true),
+		Dragging:  lipgloss.NewStyle().Faint(true),
+		Indicator: lipgloss.NewStyle().Foreground(lipgloss.Color("#44aaaa")),
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Reorderable List
+/*
+ - Owns a slice of items which can be reordered by dragging or with Alt+Up/Down while focused
+ - Items are given a DragHandler whose DragType is the list ID, and the list acts as their drop target
+ - Height limits the number of visible rows, Offset is the first visible row
+ - Dragging within ScrollMargin rows of an edge scrolls the list every ScrollInterval
+*/
+type Model struct {
+	Items          []Item
+	Height         int
+	Offset         int
+	Cursor         int
+	Focused        bool
+	ScrollMargin   int
+	ScrollInterval time.Duration
+	Styles         Styles
+
+	insert      int
+	scrollDir   int
+	scrolling   bool
+	interaction *teaspoon.Interactable
+	drag        *teaspoon.DragHandler
+}
+
+//* Creation Method
+/*
+ - Returns a new list owning the provided items
+ - The list's DropHandler only accepts items dragged from this list
+*/
+func New(items ...Item) Model {
+	id := zone.NewPrefix()
+
+	drop := &teaspoon.DropHandler{
+		AcceptedDropTypes: []string{id},
+	}
+	drop.OnDropHover = func(element teaspoon.Interactive, dragEvent teaspoon.DragEvent) (teaspoon.Interactive, tea.Cmd) {
+		return element.(Model).dropHover(drop, dragEvent)
+	}
+	drop.OnDropLeave = func(element teaspoon.Interactive, dragEvent teaspoon.DragEvent) (teaspoon.Interactive, tea.Cmd) {
+		m := element.(Model)
+		m.insert = -1
+		m.scrollDir = 0
+		return drop.DefaultDropLeave(m, dragEvent)
+	}
+	drop.OnDropRelease = func(element teaspoon.Interactive, dragEvent teaspoon.DragEvent) (teaspoon.Interactive, tea.Cmd) {
+		return element.(Model).dropRelease(drop, dragEvent)
+	}
+
+	m := Model{
+		ScrollMargin:   1,
+		ScrollInterval: 100 * time.Millisecond,
+		Styles:         DefaultStyles(),
+		insert:         -1,
+		interaction: &teaspoon.Interactable{
+			ID:   id,
+			Drop: drop,
+		},
+		drag: &teaspoon.DragHandler{
+			DragType:     id,
+			EmitMessages: true,
+		},
+	}
+	return m.SetItems(items)
+}
+
+//* Implement Interactive Interface
+/*
+ - Provides reference to the list's own interaction, used as the drop target for its rows
+*/
+func (m Model) GetInteraction() *teaspoon.Interactable {
+	return m.interaction
+}
+
+//* Set Items
+/*
+ - Replaces the list's items, giving each a Click and Drag handler if it does not define one
+*/
+func (m Model) SetItems(items []Item) Model {
+	for _, item := range items {
+		interaction := item.GetInteraction()
+		if interaction.Click == nil {
+			interaction.Click = &teaspoon.ClickHandler{
+				OnClick: func(element teaspoon.Interactive, mouseMsg tea.MouseMsg) (teaspoon.Interactive, tea.Cmd) {
+					return element, nil
+				},
+			}
+		}
+		if interaction.Drag == nil {
+			interaction.Drag = m.drag
+		}
+	}
+	m.Items = items
+	m.Cursor = clamp(m.Cursor, 0, len(items)-1)
+	m.Offset = clamp(m.Offset, 0, m.maxOffset())
+	return m
+}
+
+//* Move Item
+/*
+ - Moves the item at index from to index to, keeping the cursor on the moved item
+ - Returns a command emitting a ReorderEvent, or nil if nothing moved
+*/
+func (m Model) MoveItem(from, to int) (Model, tea.Cmd) {
+	if from < 0 || from >= len(m.Items) || to < 0 || to >= len(m.Items) || from == to {
+		return m, nil
+	}
+
+	items := make([]Item, 0, len(m.Items))
+	items = append(items, m.Items[:from]...)
+	items = append(items, m.Items[from+1:]...)
+	items = append(items[:to], append([]Item{m.Items[from]}, items[to:]...)...)
+	m.Items = items
+	m.Cursor = to
+	m.scrollTo(to)
+
+	reorderEvent := ReorderEvent{ID: m.interaction.ID, From: from, To: to}
+	return m, func() tea.Msg {
+		return reorderEvent
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Update Routine
+/*
+ - Mouse messages are passed to every item so drags continue outside of their row
+ - Wheel messages within the list scroll it rather than reaching the items
+ - Drag events and other external messages are passed to the items and the list's own drop handling
+*/
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.MouseMsg:
+		if tea.MouseEvent(msg).IsWheel() {
+			if zone.Get(m.interaction.ID).InBounds(msg) {
+				switch msg.Button {
+				case tea.MouseButtonWheelUp:
+					m.scroll(-1)
+				case tea.MouseButtonWheelDown:
+					m.scroll(1)
+				}
+			}
+			return m, nil
+		}
+
+		for i, item := range m.Items {
+			if msg.Action == tea.MouseActionPress && item.GetInteraction().HandleIsInside(item, msg) {
+				m.Cursor = i
+			}
+		}
+		return m, teaspoon.Forward(m.Items, msg)
+
+	case tea.KeyMsg:
+		if m.Focused {
+			switch msg.Type {
+			case tea.KeyUp:
+				if msg.Alt {
+					m, cmd = m.MoveItem(m.Cursor, m.Cursor-1)
+					cmds = append(cmds, cmd)
+				} else {
+					m.Cursor = clamp(m.Cursor-1, 0, len(m.Items)-1)
+					m.scrollTo(m.Cursor)
+				}
+			case tea.KeyDown:
+				if msg.Alt {
+					m, cmd = m.MoveItem(m.Cursor, m.Cursor+1)
+					cmds = append(cmds, cmd)
+				} else {
+					m.Cursor = clamp(m.Cursor+1, 0, len(m.Items)-1)
+					m.scrollTo(m.Cursor)
+				}
+			}
+		}
+
+	case autoScrollMsg:
+		if msg.ID != m.interaction.ID {
+			return m, nil
+		}
+		if m.scrollDir == 0 || !m.interaction.IsBelowDrop {
+			m.scrolling = false
+			return m, nil
+		}
+		m.scroll(m.scrollDir)
+		return m, m.autoScroll()
+
+	case teaspoon.DragEvent:
+		var element teaspoon.Interactive
+		element, cmd = m.interaction.HandleExternalEvent(m, msg)
+		m = element.(Model)
+		cmds = append(cmds, cmd)
+	}
+
+	cmds = append(cmds, teaspoon.Forward(m.Items, msg))
+	return m, tea.Batch(cmds...)
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* List View
+/*
+ - Renders the visible rows, marking each with its item ID and the list with its own ID
+ - An insertion indicator is drawn between rows while an acceptable item is dragged over the list
+ - A blank line is reserved below the rows for the indicator, so the list keeps its height while dragging
+*/
+func (m Model) View() string {
+	start, end := m.visibleRange()
+
+	width := 0
+	for i := start; i < end; i++ {
+		width = max(width, lipgloss.Width(m.Items[i].View()))
+	}
+	indicator := m.Styles.Indicator.Render(strings.Repeat("─", max(width, 1)))
+
+	var rows []string
+	for i := start; i < end; i++ {
+		if i == m.insert {
+			rows = append(rows, indicator)
+		}
+
+		item := m.Items[i]
+		interaction := item.GetInteraction()
+
+		style := m.Styles.Row
+		if interaction.IsDragging {
+			style = m.Styles.Dragging
+		} else if m.Focused && i == m.Cursor {
+			style = m.Styles.Cursor
+		}
+		rows = append(rows, zone.Mark(interaction.ID, style.Render(item.View())))
+	}
+	if m.insert >= end {
+		rows = append(rows, indicator)
+	} else if m.insert < start {
+		rows = append(rows, "")
+	}
+
+	return zone.Mark(m.interaction.ID, lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Drop Hover Behaviour
+/*
+ - Determines the insertion index from the pointer position and whether the list should auto-scroll
+*/
+func (m Model) dropHover(drop *teaspoon.DropHandler, dragEvent teaspoon.DragEvent) (teaspoon.Interactive, tea.Cmd) {
+	var cmds []tea.Cmd
+
+	m.insert = -1
+	if drop.HandleIsAcceptable(m, dragEvent) {
+		m.insert = m.insertionIndex(dragEvent.MouseMsg, m.indexOf(dragEvent.ID))
+	}
+
+	m.scrollDir = 0
+	if bounds := zone.Get(m.interaction.ID); !bounds.IsZero() && m.Height > 0 {
+		switch y := dragEvent.MouseMsg.Y; {
+		case y < bounds.StartY+m.ScrollMargin:
+			m.scrollDir = -1
+		case y > bounds.EndY-m.ScrollMargin:
+			m.scrollDir = 1
+		}
+	}
+	if m.scrollDir != 0 && !m.scrolling {
+		m.scrolling = true
+		cmds = append(cmds, m.autoScroll())
+	}
+
+	element, cmd := drop.DefaultDropHover(m, dragEvent)
+	return element, tea.Batch(append(cmds, cmd)...)
+}
+
+//* Drop Release Behaviour
+/*
+ - Moves an accepted item to the insertion index under the pointer and emits a ReorderEvent
+*/
+func (m Model) dropRelease(drop *teaspoon.DropHandler, dragEvent teaspoon.DragEvent) (teaspoon.Interactive, tea.Cmd) {
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+
+	from := m.indexOf(dragEvent.ID)
+	acceptable := drop.HandleIsAcceptable(m, dragEvent)
+	to := m.insertionIndex(dragEvent.MouseMsg, from)
+
+	m.insert = -1
+	m.scrollDir = 0
+
+	if acceptable && from >= 0 {
+		if to > from {
+			to--
+		}
+		m, cmd = m.MoveItem(from, to)
+		cmds = append(cmds, cmd)
+	}
+
+	element, cmd := drop.DefaultDropRelease(m, dragEvent)
+	return element, tea.Batch(append(cmds, cmd)...)
+}
+
+//* Insertion Index
+/*
+ - Returns the index of the gap under the pointer among the visible rows
+*/
+func (m Model) insertionIndex(mouseMsg tea.MouseMsg, from int) int {
+	start, end := m.visibleRange()
+	visible := make([]teaspoon.Interactive, 0, end-start)
+	for _, item := range m.Items[start:end] {
+		visible = append(visible, item)
+	}
+	return teaspoon.InsertionIndex(teaspoon.YAxis, mouseMsg, from, start, visible...)
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Scrolling Helpers
+/*
+ - Keep the offset within range and the given row visible
+*/
+func (m *Model) scroll(delta int) {
+	m.Offset = clamp(m.Offset+delta, 0, m.maxOffset())
+}
+
+func (m *Model) scrollTo(index int) {
+	if m.Height <= 0 {
+		return
+	}
+	if index < m.Offset {
+		m.Offset = index
+	} else if index >= m.Offset+m.Height {
+		m.Offset = index - m.Height + 1
+	}
+	m.scroll(0)
+}
+
+func (m Model) autoScroll() tea.Cmd {
+	id := m.interaction.ID
+	return tea.Tick(m.ScrollInterval, func(time.Time) tea.Msg {
+		return autoScrollMsg{ID: id}
+	})
+}
+
+func (m Model) maxOffset() int {
+	if m.Height <= 0 {
+		return 0
+	}
+	return max(len(m.Items)-m.Height, 0)
+}
+
+func (m Model) visibleRange() (int, int) {
+	if m.Height <= 0 {
+		return 0, len(m.Items)
+	}
+	return m.Offset, min(m.Offset+m.Height, len(m.Items))
+}
+
+func (m Model) indexOf(id string) int {
+	for i, item := range m.Items {
+		if item.GetInteraction().ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func clamp(v, low, high int) int {
+	return max(low, min(v, high))
+}
@@ -0,0 +1,109 @@
+package list
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jordanella/teaspoon"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+type item struct {
+	label       string
+	interaction *teaspoon.Interactable
+}
+
+func (i item) GetInteraction() *teaspoon.Interactable {
+	return i.interaction
+}
+
+func (i item) View() string {
+	return i.label
+}
+
+// Scans the view and waits for the zone with the given ID to be stored
+func scan(t *testing.T, view, id string) {
+	t.Helper()
+	zone.Scan(view)
+	for deadline := time.Now().Add(time.Second); zone.Get(id).IsZero(); time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("zones were not stored")
+		}
+	}
+}
+
+// Updates the model with the message and every message its commands produce, returning those messages
+func run(m Model, msg tea.Msg) (Model, []tea.Msg) {
+	var produced []tea.Msg
+	queue := []tea.Msg{msg}
+	for len(queue) > 0 {
+		var cmd tea.Cmd
+		m, cmd = m.Update(queue[0])
+		queue = append(queue[1:], messages(cmd)...)
+		produced = append(produced, messages(cmd)...)
+	}
+	return m, produced
+}
+
+func messages(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	switch msg := cmd().(type) {
+	case nil:
+		return nil
+	case tea.BatchMsg:
+		var msgs []tea.Msg
+		for _, cmd := range msg {
+			msgs = append(msgs, messages(cmd)...)
+		}
+		return msgs
+	default:
+		return []tea.Msg{msg}
+	}
+}
+
+func labels(m Model) []string {
+	var labels []string
+	for _, i := range m.Items {
+		labels = append(labels, i.View())
+	}
+	return labels
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+func TestListDragReorder(t *testing.T) {
+	zone.NewGlobal()
+	var items []Item
+	for _, label := range []string{"a", "b", "c", "d"} {
+		items = append(items, item{label: label, interaction: &teaspoon.Interactable{ID: zone.NewPrefix()}})
+	}
+	m := New(items...)
+	view := m.View()
+	height := lipgloss.Height(view)
+	scan(t, view, m.interaction.ID)
+
+	m, _ = run(m, tea.MouseMsg{X: 0, Y: 0, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	m, _ = run(m, tea.MouseMsg{X: 0, Y: 2, Action: tea.MouseActionMotion, Button: tea.MouseButtonLeft})
+	if m.insert != 3 {
+		t.Errorf("indicator at %d while dragging over c, want 3", m.insert)
+	}
+	if dragging := lipgloss.Height(m.View()); dragging != height {
+		t.Errorf("list is %d lines tall while dragging, want %d", dragging, height)
+	}
+
+	m, produced := run(m, tea.MouseMsg{X: 0, Y: 2, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft})
+	if got, want := labels(m), []string{"b", "c", "a", "d"}; !slices.Equal(got, want) {
+		t.Errorf("items %v after the drop, want %v", got, want)
+	}
+	if !slices.Contains(produced, tea.Msg(ReorderEvent{ID: m.interaction.ID, From: 0, To: 2})) {
+		t.Errorf("no reorder event from 0 to 2 in %v", produced)
+	}
+	if m.insert != -1 || m.Items[2].GetInteraction().IsDragging {
+		t.Errorf("indicator at %d and dragging %v after the drop", m.insert, m.Items[2].GetInteraction().IsDragging)
+	}
+}
@@ -0,0 +1,356 @@
+package teaspoon
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Rectangle
+/*
+ - Inclusive range of cells between the Min and Max corners
+*/
+type Rect struct {
+	Min, Max Point
+}
+
+//* Rectangle From Corners
+/*
+ - Returns the rectangle spanning two opposite corners given in any order
+*/
+func RectFrom(a, b Point) Rect {
+	return Rect{
+		Min: Point{X: min(a.X, b.X), Y: min(a.Y, b.Y)},
+		Max: Point{X: max(a.X, b.X), Y: max(a.Y, b.Y)},
+	}
+}
+
+//* Rectangle Size
+/*
+ - Returns the number of columns and rows covered by the rectangle
+*/
+func (r Rect) Size() (int, int) {
+	return r.Max.X - r.Min.X + 1, r.Max.Y - r.Min.Y + 1
+}
+
+//* Rectangle Intersection
+/*
+ - Returns true if the rectangles share at least one cell
+*/
+func (r Rect) Intersects(other Rect) bool {
+	return r.Min.X <= other.Max.X && other.Min.X <= r.Max.X && r.Min.Y <= other.Max.Y && other.Min.Y <= r.Max.Y
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Selection Changed Event
+/*
+ - Emitted by a marquee whenever the selection of its group changes while the rectangle is drawn or cancelled
+ - Selected lists every selected member in group order, Added and Removed the changes since the previous event
+*/
+type SelectionChanged struct {
+	ID       string
+	Selected []string
+	Added    []string
+	Removed  []string
+}
+
+//* Marquee Styles
+/*
+ - Border runes and style used to draw the selection rectangle
+*/
+type MarqueeStyles struct {
+	Border lipgloss.Border
+	Style  lipgloss.Style
+}
+
+//* Default Marquee Styles
+/*
+ - Returns a normal border drawn in the accent colour
+*/
+func DefaultMarqueeStyles() MarqueeStyles {
+	return MarqueeStyles{
+		Border: lipgloss.NormalBorder(),
+		Style:  lipgloss.NewStyle().Foreground(lipgloss.Color("#44aaaa")),
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Marquee Selection
+/*
+ - Rubber band selection of a group's members, started by pressing the left button on empty background
+ - Every enabled member whose bounds intersect the rectangle is selected, using HandleBounds for each member
+ - A plain drag replaces the selection, Shift adds to the selection held when the drag began and Ctrl toggles it
+ - Pressing on a member leaves the press to the member, so clicks and drags of members are unaffected
+ - If Area is set the drag must begin within that zone, such as the container of a file grid
+ - Groups in SingleSelection mode cannot hold the rectangle's selection, so the marquee never starts for them
+ - SelectionChanged is emitted each time the selection changes, and Escape restores the selection held when it began
+*/
+type Marquee struct {
+	Group  *SelectionGroup
+	Area   string
+	Styles MarqueeStyles
+
+	id       string
+	active   bool
+	origin   Point
+	current  Point
+	mode     marqueeMode
+	initial  map[string]bool
+	selected []string
+}
+
+type marqueeMode int
+
+const (
+	marqueeReplace marqueeMode = iota
+	marqueeAdd
+	marqueeToggle
+)
+
+//* Creation Method
+/*
+ - Returns a new marquee selecting members of the group
+*/
+func NewMarquee(group *SelectionGroup) *Marquee {
+	return &Marquee{
+		Group:  group,
+		Styles: DefaultMarqueeStyles(),
+		id:     zone.NewPrefix(),
+	}
+}
+
+//* Marquee ID
+/*
+ - Returns the ID attached to the marquee's SelectionChanged events
+*/
+func (m *Marquee) ID() string {
+	return m.id
+}
+
+//* Is Active
+/*
+ - Returns true while the selection rectangle is being drawn
+*/
+func (m *Marquee) IsActive() bool {
+	return m.active
+}
+
+//* Selection Rectangle
+/*
+ - Returns the rectangle between the press and the pointer, which is only meaningful while active
+*/
+func (m *Marquee) Rect() Rect {
+	return RectFrom(m.origin, m.current)
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Update Routine
+/*
+ - Starts, resizes and ends the rectangle from mouse messages, and cancels it on Escape
+*/
+func (m *Marquee) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.active && msg.Type == tea.KeyEsc {
+			return m.Cancel()
+		}
+
+	case tea.MouseMsg:
+		if tea.MouseEvent(msg).IsWheel() {
+			return nil
+		}
+		switch {
+		case !m.active && msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft:
+			if m.Group.Mode == SingleSelection || !m.onBackground(msg) {
+				return nil
+			}
+			m.begin(msg)
+			return m.apply()
+
+		case m.active && msg.Action == tea.MouseActionMotion:
+			m.current = Point{X: msg.X, Y: msg.Y}
+			cmd := m.apply()
+			if msg.Button == tea.MouseButtonNone {
+				m.active = false
+			}
+			return cmd
+
+		case m.active && msg.Action == tea.MouseActionRelease:
+			m.current = Point{X: msg.X, Y: msg.Y}
+			cmd := m.apply()
+			m.active = false
+			return cmd
+		}
+	}
+	return nil
+}
+
+//* Cancel Selection
+/*
+ - Ends the rectangle and restores the selection held when it began
+*/
+func (m *Marquee) Cancel() tea.Cmd {
+	if !m.active {
+		return nil
+	}
+	m.active = false
+	for _, member := range m.Group.Members() {
+		interaction := member.GetInteraction()
+		interaction.IsSelected = m.initial[interaction.ID]
+	}
+	return m.changed()
+}
+
+func (m *Marquee) onBackground(msg tea.MouseMsg) bool {
+	if m.Area != "" && !zone.Get(m.Area).InBounds(msg) {
+		return false
+	}
+	for _, member := range m.Group.Members() {
+		if member.GetInteraction().HandleIsInside(member, msg) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Marquee) begin(msg tea.MouseMsg) {
+	m.active = true
+	m.origin = Point{X: msg.X, Y: msg.Y}
+	m.current = m.origin
+
+	switch {
+	case msg.Ctrl:
+		m.mode = marqueeToggle
+	case msg.Shift:
+		m.mode = marqueeAdd
+	default:
+		m.mode = marqueeReplace
+	}
+
+	m.initial = map[string]bool{}
+	m.selected = m.Group.SelectedIDs()
+	for _, id := range m.selected {
+		m.initial[id] = true
+	}
+}
+
+//* Selection Application
+/*
+ - Selects the members intersecting the rectangle according to the mode, relative to the initial selection
+*/
+func (m *Marquee) apply() tea.Cmd {
+	rect := m.Rect()
+	for _, member := range m.Group.Members() {
+		interaction := member.GetInteraction()
+		initial := m.initial[interaction.ID]
+		if !interaction.IsEnabled(ClickCapability) {
+			interaction.IsSelected = initial
+			continue
+		}
+
+		bounds, ok := interaction.HandleBounds(member)
+		inside := ok && rect.Intersects(bounds)
+		switch m.mode {
+		case marqueeReplace:
+			interaction.IsSelected = inside
+		case marqueeAdd:
+			interaction.IsSelected = initial || inside
+		case marqueeToggle:
+			interaction.IsSelected = initial != inside
+		}
+	}
+	return m.changed()
+}
+
+func (m *Marquee) changed() tea.Cmd {
+	selected := m.Group.SelectedIDs()
+	previous := map[string]bool{}
+	for _, id := range m.selected {
+		previous[id] = true
+	}
+
+	changedEvent := SelectionChanged{ID: m.id, Selected: selected}
+	for _, id := range selected {
+		if !previous[id] {
+			changedEvent.Added = append(changedEvent.Added, id)
+		}
+		delete(previous, id)
+	}
+	for _, id := range m.selected {
+		if previous[id] {
+			changedEvent.Removed = append(changedEvent.Removed, id)
+		}
+	}
+	m.selected = selected
+
+	if len(changedEvent.Added) == 0 && len(changedEvent.Removed) == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		return changedEvent
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Marquee Layers
+/*
+ - Returns the outline of the rectangle as layers for Compose, or nil while inactive
+ - Each edge is its own layer so the content within the rectangle stays visible
+*/
+func (m *Marquee) Layers(z int) []Layer {
+	if !m.active {
+		return nil
+	}
+
+	rect := m.Rect()
+	width, height := rect.Size()
+	border, style := m.Styles.Border, m.Styles.Style
+
+	if width == 1 || height == 1 {
+		var view string
+		switch {
+		case width == 1 && height == 1:
+			view = border.TopLeft
+		case height == 1:
+			view = strings.Repeat(border.Top, width)
+		default:
+			view = strings.TrimSuffix(strings.Repeat(border.Left+"\n", height), "\n")
+		}
+		return []Layer{{View: style.Render(view), X: rect.Min.X, Y: rect.Min.Y, Z: z}}
+	}
+
+	top := border.TopLeft + strings.Repeat(border.Top, width-2) + border.TopRight
+	bottom := border.BottomLeft + strings.Repeat(border.Bottom, width-2) + border.BottomRight
+	layers := []Layer{
+		{View: style.Render(top), X: rect.Min.X, Y: rect.Min.Y, Z: z},
+		{View: style.Render(bottom), X: rect.Min.X, Y: rect.Max.Y, Z: z},
+	}
+	if height > 2 {
+		left := strings.TrimSuffix(strings.Repeat(border.Left+"\n", height-2), "\n")
+		right := strings.TrimSuffix(strings.Repeat(border.Right+"\n", height-2), "\n")
+		layers = append(layers,
+			Layer{View: style.Render(left), X: rect.Min.X, Y: rect.Min.Y + 1, Z: z},
+			Layer{View: style.Render(right), X: rect.Max.X, Y: rect.Min.Y + 1, Z: z},
+		)
+	}
+	return layers
+}
+
+//* Compose Over Base
+/*
+ - Draws the rectangle over the base view while active, otherwise returns the base view unchanged
+*/
+func (m *Marquee) Compose(base string) string {
+	if !m.active {
+		return base
+	}
+	return Compose(base, m.Layers(0)...)
+}
@@ -0,0 +1,39 @@
+package teaspoon
+
+import (
+	"testing"
+)
+
+func TestRectIntersects(t *testing.T) {
+	rect := RectFrom(Point{X: 2, Y: 2}, Point{X: 5, Y: 4})
+
+	tests := []struct {
+		name  string
+		other Rect
+		want  bool
+	}{
+		{name: "identical", other: rect, want: true},
+		{name: "contained", other: RectFrom(Point{X: 3, Y: 3}, Point{X: 3, Y: 3}), want: true},
+		{name: "containing", other: RectFrom(Point{X: 0, Y: 0}, Point{X: 9, Y: 9}), want: true},
+		{name: "overlapping corner", other: RectFrom(Point{X: 5, Y: 4}, Point{X: 8, Y: 8}), want: true},
+		{name: "shared edge", other: RectFrom(Point{X: 0, Y: 4}, Point{X: 2, Y: 6}), want: true},
+		{name: "crossing", other: RectFrom(Point{X: 3, Y: 0}, Point{X: 4, Y: 9}), want: true},
+		{name: "left", other: RectFrom(Point{X: 0, Y: 2}, Point{X: 1, Y: 4})},
+		{name: "right", other: RectFrom(Point{X: 6, Y: 2}, Point{X: 8, Y: 4})},
+		{name: "above", other: RectFrom(Point{X: 2, Y: 0}, Point{X: 5, Y: 1})},
+		{name: "below", other: RectFrom(Point{X: 2, Y: 5}, Point{X: 5, Y: 5})},
+		{name: "diagonal", other: RectFrom(Point{X: 6, Y: 5}, Point{X: 7, Y: 6})},
+		{name: "reversed corners", other: RectFrom(Point{X: 9, Y: 9}, Point{X: 4, Y: 3}), want: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := rect.Intersects(test.other); got != test.want {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+			if got := test.other.Intersects(rect); got != test.want {
+				t.Errorf("reversed got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
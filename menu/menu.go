@@ -0,0 +1,590 @@
+package menu
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jordanella/teaspoon"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Menu Item
+/*
+ - An entry of the menu, activated by clicking or Enter unless Disabled
+ - Items with a Submenu open it on hover dwell or Right instead of activating
+ - Separator items are drawn as a rule and cannot be hovered or activated
+*/
+type Item struct {
+	ID        string
+	Label     string
+	Disabled  bool
+	Separator bool
+	Submenu   []Item
+}
+
+//* Separator Item
+/*
+ - Returns an item drawn as a rule between groups of items
+*/
+func Separator() Item {
+	return Item{Separator: true}
+}
+
+//* Menu Select Event
+/*
+ - Emitted when an item is activated, MenuID is the ID of the outermost menu
+*/
+type MenuSelectEvent struct {
+	MenuID string
+	ItemID string
+}
+
+//* Open Message
+/*
+ - Opens the menu matching ID at the given position, as returned by OpenCmd
+*/
+type OpenMsg struct {
+	ID   string
+	X, Y int
+}
+
+//* Dwell Message
+/*
+ - Scheduled when a row is hovered, opening its submenu if it is still hovered once HoverDelay has passed
+*/
+type dwellMsg struct {
+	ID       string
+	index    int
+	sequence int
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Menu Styles
+/*
+ - Styles for the menu box and its rows
+*/
+type Styles struct {
+	Box       lipgloss.Style
+	Item      lipgloss.Style
+	Hovered   lipgloss.Style
+	Disabled  lipgloss.Style
+	Separator lipgloss.Style
+}
+
+//* Default Menu Styles
+/*
+ - Returns a rounded box with a highlighted hovered row and faint disabled rows
+*/
+func DefaultStyles() Styles {
+	return Styles{
+		Box:       lipgloss.NewStyle().Border(lipgloss.RoundedBorder()),
+		Item:      lipgloss.NewStyle().Padding(0, 1),
+		Hovered:   lipgloss.NewStyle().Padding(0, 1).Reverse(true),
+		Disabled:  lipgloss.NewStyle().Padding(0, 1).Faint(true),
+		Separator: lipgloss.NewStyle().Faint(true),
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Menu Row
+/*
+ - Interactive row of the menu, recording hover entry and clicks for the menu to resolve
+ - The row's submenu is built when first opened and reused afterwards, keeping its zone IDs
+*/
+type row struct {
+	entered     bool
+	clicked     bool
+	submenu     *ContextMenu
+	interaction *teaspoon.Interactable
+}
+
+func (r *row) GetInteraction() *teaspoon.Interactable {
+	return r.interaction
+}
+
+func newRow(id string) *row {
+	hover := &teaspoon.HoverHandler{}
+	hover.OnMouseEnter = func(element teaspoon.Interactive, mouseMsg tea.MouseMsg) (teaspoon.Interactive, tea.Cmd) {
+		element.(*row).entered = true
+		return hover.DefaultMouseEnter(element, mouseMsg)
+	}
+
+	return &row{
+		interaction: &teaspoon.Interactable{
+			ID:    id,
+			Hover: hover,
+			Click: &teaspoon.ClickHandler{
+				OnClick: func(element teaspoon.Interactive, mouseMsg tea.MouseMsg) (teaspoon.Interactive, tea.Cmd) {
+					element.(*row).clicked = true
+					return element, nil
+				},
+			},
+		},
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Context Menu
+/*
+ - Overlay menu opened at the pointer and clamped within ScreenWidth and ScreenHeight
+ - View renders the menu box only, it should be drawn over the base view at Position
+ - Layers returns the menu and its submenus positioned for teaspoon.Compose
+ - Rows are hoverable and clickable, Up and Down move between rows skipping separators and disabled items
+ - Submenus open after hovering their row for HoverDelay or with Right, and close with Left
+ - Clicking outside of the menu and its submenus or pressing Escape dismisses it
+ - Items may be replaced at any time, rows are rebuilt to match on the next update or render
+*/
+type ContextMenu struct {
+	Items        []Item
+	ScreenWidth  int
+	ScreenHeight int
+	HoverDelay   time.Duration
+	Styles       Styles
+
+	id       string
+	rootID   string
+	open     bool
+	x, y     int
+	cursor   int
+	rows     []*row
+	parent   int
+	submenu  *ContextMenu
+	sequence int
+}
+
+//* Creation Method
+/*
+ - Returns a new closed menu containing the provided items
+*/
+func New(items ...Item) ContextMenu {
+	id := zone.NewPrefix()
+	m := ContextMenu{
+		Items:      items,
+		HoverDelay: 300 * time.Millisecond,
+		Styles:     DefaultStyles(),
+		id:         id,
+		rootID:     id,
+		cursor:     -1,
+	}
+	m.syncRows()
+	return m
+}
+
+//* Row Synchronisation
+/*
+ - Rebuilds the rows if items have been added or removed, keeping the rows of remaining items
+ - A cursor or open submenu beyond the remaining items is cleared
+*/
+func (m *ContextMenu) syncRows() {
+	if len(m.rows) == len(m.Items) {
+		return
+	}
+	rows := make([]*row, len(m.Items))
+	for i := range rows {
+		if i < len(m.rows) {
+			rows[i] = m.rows[i]
+		} else {
+			rows[i] = newRow(fmt.Sprintf("%srow%d", m.id, i))
+		}
+	}
+	m.rows = rows
+
+	if m.cursor >= len(m.Items) {
+		m.cursor = -1
+	}
+	if m.submenu != nil && m.submenu.parent >= len(m.Items) {
+		m.submenu = nil
+	}
+}
+
+//* Menu ID
+/*
+ - Returns the ID matched by OpenMsg and attached to MenuSelectEvents
+*/
+func (m ContextMenu) ID() string {
+	return m.rootID
+}
+
+//* Is Open
+/*
+ - Returns true while the menu is displayed
+*/
+func (m ContextMenu) IsOpen() bool {
+	return m.open
+}
+
+//* Position
+/*
+ - Returns the clamped top left cell at which the menu should be drawn
+*/
+func (m ContextMenu) Position() (int, int) {
+	return m.x, m.y
+}
+
+//* Open At Position
+/*
+ - Opens the menu with its top left corner at the pointer, clamped to the screen edges
+*/
+func (m ContextMenu) OpenAt(x, y int) ContextMenu {
+	m.syncRows()
+	width, height := lipgloss.Size(m.render())
+	if m.ScreenWidth > 0 {
+		x = min(x, m.ScreenWidth-width)
+	}
+	if m.ScreenHeight > 0 {
+		y = min(y, m.ScreenHeight-height)
+	}
+	m.x, m.y = max(x, 0), max(y, 0)
+	m.open = true
+	m.cursor = -1
+	m.submenu = nil
+	return m
+}
+
+//* Open Command
+/*
+ - Returns a command opening the menu at the pointer, for use within OnRightClick handlers
+*/
+func (m ContextMenu) OpenCmd(mouseMsg tea.MouseMsg) tea.Cmd {
+	openMsg := OpenMsg{ID: m.rootID, X: mouseMsg.X, Y: mouseMsg.Y}
+	return func() tea.Msg {
+		return openMsg
+	}
+}
+
+//* Close
+/*
+ - Closes the menu and any open submenus
+*/
+func (m ContextMenu) Close() ContextMenu {
+	m.open = false
+	m.submenu = nil
+	m.cursor = -1
+	for _, r := range m.rows {
+		r.interaction.IsHovered = false
+	}
+	return m
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Update Routine
+/*
+ - Window size messages set the screen bounds used for clamping
+ - While open, mouse messages are offered to the deepest submenu first, and presses outside every level dismiss
+*/
+func (m ContextMenu) Update(msg tea.Msg) (ContextMenu, tea.Cmd) {
+	m.syncRows()
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.ScreenWidth, m.ScreenHeight = msg.Width, msg.Height
+		return m, nil
+
+	case OpenMsg:
+		if msg.ID == m.rootID {
+			m = m.OpenAt(msg.X, msg.Y)
+		}
+		return m, nil
+	}
+
+	if !m.open {
+		return m, nil
+	}
+
+	switch msg := msg.(type) {
+	case tea.MouseMsg:
+		if msg.Action == tea.MouseActionPress && !m.contains(msg) {
+			return m.Close(), nil
+		}
+		m, cmd, _ := m.handleMouse(msg)
+		return m, cmd
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+
+	case dwellMsg:
+		return m.handleDwell(msg), nil
+	}
+
+	return m, nil
+}
+
+//* Mouse Handling
+/*
+ - Passes the message to the open submenu, then to this menu's rows if the submenu did not contain it
+ - Returns true if the menu should close after an item was activated
+*/
+func (m ContextMenu) handleMouse(mouseMsg tea.MouseMsg) (ContextMenu, tea.Cmd, bool) {
+	var cmds []tea.Cmd
+
+	if m.submenu != nil {
+		submenu, cmd, activated := m.submenu.handleMouse(mouseMsg)
+		if activated {
+			return m.Close(), cmd, true
+		}
+		m.submenu = &submenu
+		if submenu.contains(mouseMsg) {
+			return m, cmd, false
+		}
+		cmds = append(cmds, cmd)
+	}
+
+	for i, r := range m.rows {
+		if m.Items[i].Separator {
+			continue
+		}
+		if _, cmd := r.interaction.HandleMouseMsg(r, mouseMsg); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
+		if r.entered {
+			r.entered = false
+			m.cursor = i
+			m.sequence++
+			dwell := dwellMsg{ID: m.id, index: i, sequence: m.sequence}
+			cmds = append(cmds, tea.Tick(m.HoverDelay, func(time.Time) tea.Msg {
+				return dwell
+			}))
+		}
+
+		if r.clicked {
+			r.clicked = false
+			item := m.Items[i]
+			switch {
+			case item.Disabled:
+			case len(item.Submenu) > 0:
+				m = m.openSubmenu(i)
+			default:
+				return m.Close(), m.selectCmd(item), true
+			}
+		}
+	}
+
+	return m, tea.Batch(cmds...), false
+}
+
+//* Key Handling
+/*
+ - Keys are handled by the deepest open submenu, Escape dismisses every level
+*/
+func (m ContextMenu) handleKey(keyMsg tea.KeyMsg) (ContextMenu, tea.Cmd) {
+	if keyMsg.Type == tea.KeyEsc {
+		return m.Close(), nil
+	}
+
+	if m.submenu != nil && m.submenu.open {
+		if keyMsg.Type == tea.KeyLeft && m.submenu.submenu == nil {
+			m.submenu = nil
+			return m, nil
+		}
+		submenu, cmd := m.submenu.handleKey(keyMsg)
+		if !submenu.open {
+			return m.Close(), cmd
+		}
+		m.submenu = &submenu
+		return m, cmd
+	}
+
+	switch keyMsg.Type {
+	case tea.KeyUp:
+		m.cursor = m.step(-1)
+	case tea.KeyDown:
+		m.cursor = m.step(1)
+	case tea.KeyRight:
+		if m.cursor >= 0 && len(m.Items[m.cursor].Submenu) > 0 && !m.Items[m.cursor].Disabled {
+			m = m.openSubmenu(m.cursor)
+			m.submenu.cursor = m.submenu.step(1)
+		}
+	case tea.KeyEnter:
+		if m.cursor < 0 || m.Items[m.cursor].Disabled {
+			return m, nil
+		}
+		if len(m.Items[m.cursor].Submenu) > 0 {
+			m = m.openSubmenu(m.cursor)
+			m.submenu.cursor = m.submenu.step(1)
+			return m, nil
+		}
+		return m.Close(), m.selectCmd(m.Items[m.cursor])
+	}
+	return m, nil
+}
+
+//* Dwell Handling
+/*
+ - Opens the submenu of a row still hovered after HoverDelay, or closes the open submenu for other rows
+*/
+func (m ContextMenu) handleDwell(dwell dwellMsg) ContextMenu {
+	if dwell.ID != m.id {
+		if m.submenu != nil {
+			submenu := m.submenu.handleDwell(dwell)
+			m.submenu = &submenu
+		}
+		return m
+	}
+	if dwell.sequence != m.sequence || dwell.index != m.cursor {
+		return m
+	}
+
+	item := m.Items[dwell.index]
+	if len(item.Submenu) > 0 && !item.Disabled {
+		return m.openSubmenu(dwell.index)
+	}
+	m.submenu = nil
+	return m
+}
+
+//* Open Submenu
+/*
+ - Opens the submenu of the given row beside it, clamped to the screen edges
+*/
+func (m ContextMenu) openSubmenu(index int) ContextMenu {
+	if m.submenu != nil && m.submenu.open && m.submenu.parent == index {
+		return m
+	}
+
+	r := m.rows[index]
+	if r.submenu == nil {
+		built := New(m.Items[index].Submenu...)
+		built.rootID = m.rootID
+		r.submenu = &built
+	}
+	submenu := r.submenu.Close()
+	submenu.Items = m.Items[index].Submenu
+	submenu.ScreenWidth, submenu.ScreenHeight = m.ScreenWidth, m.ScreenHeight
+	submenu.HoverDelay = m.HoverDelay
+	submenu.Styles = m.Styles
+
+	width, _ := lipgloss.Size(m.render())
+	rowY := m.y + index + m.Styles.Box.GetBorderTopSize()
+	if bounds := zone.Get(r.interaction.ID); !bounds.IsZero() {
+		rowY = bounds.StartY
+	}
+	submenu = submenu.OpenAt(m.x+width, rowY)
+	submenu.parent = index
+	*r.submenu = submenu
+
+	m.cursor = index
+	m.submenu = &submenu
+	return m
+}
+
+func (m ContextMenu) selectCmd(item Item) tea.Cmd {
+	menuSelectEvent := MenuSelectEvent{MenuID: m.rootID, ItemID: item.ID}
+	return func() tea.Msg {
+		return menuSelectEvent
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Menu View
+/*
+ - Renders the menu box, or an empty string while closed
+ - Open submenus are rendered separately through Submenus so each can be placed at its own position
+*/
+func (m ContextMenu) View() string {
+	if !m.open {
+		return ""
+	}
+	return m.render()
+}
+
+//* Submenu Layers
+/*
+ - Returns the view and position of each open submenu, outermost first, each above the last
+*/
+func (m ContextMenu) Submenus() []Layer {
+	var layers []Layer
+	for submenu := m.submenu; submenu != nil && submenu.open; submenu = submenu.submenu {
+		layers = append(layers, Layer{View: submenu.render(), X: submenu.x, Y: submenu.y, Z: len(layers) + 1})
+	}
+	return layers
+}
+
+//* Menu Layers
+/*
+ - Returns the menu and its open submenus as layers ready for teaspoon.Compose, or nil while closed
+*/
+func (m ContextMenu) Layers() []Layer {
+	if !m.open {
+		return nil
+	}
+	return append([]Layer{{View: m.render(), X: m.x, Y: m.y}}, m.Submenus()...)
+}
+
+//* Layer
+/*
+ - A rendered menu and the position at which it should be drawn
+*/
+type Layer = teaspoon.Layer
+
+func (m ContextMenu) render() string {
+	m.syncRows()
+
+	width := 0
+	for _, item := range m.Items {
+		width = max(width, lipgloss.Width(m.label(item)))
+	}
+
+	var rows []string
+	for i, item := range m.Items {
+		if item.Separator {
+			rows = append(rows, m.Styles.Separator.Render(strings.Repeat("─", width+m.Styles.Item.GetHorizontalPadding())))
+			continue
+		}
+
+		style := m.Styles.Item
+		switch {
+		case item.Disabled:
+			style = m.Styles.Disabled
+		case i == m.cursor:
+			style = m.Styles.Hovered
+		}
+		rows = append(rows, zone.Mark(m.rows[i].interaction.ID, style.Width(width+style.GetHorizontalPadding()).Render(m.label(item))))
+	}
+
+	return zone.Mark(m.id, m.Styles.Box.Render(lipgloss.JoinVertical(lipgloss.Left, rows...)))
+}
+
+func (m ContextMenu) label(item Item) string {
+	if len(item.Submenu) > 0 {
+		return item.Label + " ›"
+	}
+	return item.Label
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Navigation Helpers
+/*
+ - Move the cursor between selectable rows and assess whether the pointer is over any open level
+*/
+func (m ContextMenu) step(direction int) int {
+	index := m.cursor
+	if index < 0 && direction < 0 {
+		index = len(m.Items)
+	}
+	for i := 0; i < len(m.Items); i++ {
+		index = (index + direction + len(m.Items)) % len(m.Items)
+		if !m.Items[index].Separator && !m.Items[index].Disabled {
+			return index
+		}
+	}
+	return m.cursor
+}
+
+func (m ContextMenu) contains(mouseMsg tea.MouseMsg) bool {
+	if zone.Get(m.id).InBounds(mouseMsg) {
+		return true
+	}
+	return m.submenu != nil && m.submenu.open && m.submenu.contains(mouseMsg)
+}
@@ -0,0 +1,30 @@
+package menu
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+func TestSubmenuReuse(t *testing.T) {
+	zone.NewGlobal()
+	m := New(Item{ID: "more", Label: "More", Submenu: []Item{{ID: "a", Label: "A"}}}).OpenAt(0, 0)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if m.submenu == nil || !m.submenu.open {
+		t.Fatal("Right did not open the submenu")
+	}
+	id, rows := m.submenu.id, m.submenu.rows
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	m.Items[0].Submenu = append(m.Items[0].Submenu, Item{ID: "b", Label: "B"})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if m.submenu == nil || m.submenu.id != id || m.submenu.rows[0] != rows[0] {
+		t.Error("reopening the submenu built it again")
+	}
+	if len(m.submenu.rows) != 2 {
+		t.Errorf("reopened submenu has %d rows, want 2", len(m.submenu.rows))
+	}
+}
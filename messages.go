@@ -1,6 +1,8 @@
 package teaspoon
 
 import (
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -9,8 +11,17 @@ import (
 //* Mouse Message Handling
 /*
  - Responds to mouse messages with LocalHandler function or DefaultLocalHandler if it is not defined
+ - The message passes through the global and element middleware first, which may rewrite it or short-circuit
 */
 func (i *Interactable) HandleMouseMsg(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
+	return i.dispatch(element, mouseMsg, i.handleLocal)
+}
+
+func (i *Interactable) handleLocal(element Interactive, msg tea.Msg) (Interactive, tea.Cmd) {
+	mouseMsg, ok := msg.(tea.MouseMsg)
+	if !ok {
+		return element, nil
+	}
 	if i.LocalHandler != nil {
 		return i.LocalHandler(element, mouseMsg)
 	}
@@ -20,6 +31,9 @@ func (i *Interactable) HandleMouseMsg(element Interactive, mouseMsg tea.MouseMsg
 //* Default Local Handler
 /*
  - Default implementation of interpretting mouse messages to direct the appropriate interaction handlers
+ - Handlers for disabled capabilities are skipped, a hovered element leaves and a dragging element cancels
+ - Hover and drag move handlers are coalesced when MotionInterval is set, while enter and leave are immediate
+ - Motion is only coalesced for elements being hovered or dragged, so no flush is scheduled for anything else
 */
 func (i *Interactable) DefaultLocalHandler(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
 
@@ -30,17 +44,30 @@ func (i *Interactable) DefaultLocalHandler(element Interactive, mouseMsg tea.Mou
 	var cmd tea.Cmd
 	var cmds []tea.Cmd
 
-	var isInside bool
-	if i.IsInside != nil {
-		isInside = i.IsInside(element, mouseMsg)
-	} else {
-		isInside = i.DefaultIsInside(element, mouseMsg)
+	isInside := i.HandleIsInside(element, mouseMsg)
+
+	if i.Drag != nil && i.IsDragging && !i.IsEnabled(DragCapability) {
+		// Disabled Mid-Drag
+		element, cmd = i.CancelDrag(element)
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
 	}
 
 	switch mouseMsg.Action {
 	case tea.MouseActionMotion:
+		deliver := false
+		if (i.Hover != nil && isInside && i.IsEnabled(HoverCapability)) || (i.Drag != nil && i.IsDragging) {
+			deliver, cmd = i.coalesceMotion(mouseMsg)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		} else {
+			i.discardMotion()
+		}
+
 		if i.Hover != nil {
-			if isInside {
+			if isInside && i.IsEnabled(HoverCapability) {
 				if !i.IsHovered {
 					// Mouse Enter
 					element, cmd = i.Hover.HandleMouseEnter(element, mouseMsg)
@@ -48,9 +75,13 @@ func (i *Interactable) DefaultLocalHandler(element Interactive, mouseMsg tea.Mou
 						cmds = append(cmds, cmd)
 					}
 				}
-				// Mouse Hover
-				element, cmd = i.Hover.HandleMouseHover(element, mouseMsg)
-				cmds = append(cmds, cmd)
+				if deliver {
+					// Mouse Hover
+					element, cmd = i.Hover.HandleMouseHover(element, mouseMsg)
+					if cmd != nil {
+						cmds = append(cmds, cmd)
+					}
+				}
 			} else if i.IsHovered {
 				// Mouse Leave
 				element, cmd = i.Hover.HandleMouseLeave(element, mouseMsg)
@@ -60,31 +91,70 @@ func (i *Interactable) DefaultLocalHandler(element Interactive, mouseMsg tea.Mou
 			}
 		}
 
-		if i.Drag != nil && i.IsDragging {
+		if i.Drag != nil && i.IsDragging && deliver {
 			// Drag Move
 			element, cmd = i.Drag.HandleDragMove(element, mouseMsg)
 			if cmd != nil {
 				cmds = append(cmds, cmd)
 			}
+			i.LastDragActivity = time.Now()
 		}
 
 	case tea.MouseActionPress:
-		if i.Click != nil && isInside {
-			element, cmd = i.Click.HandleClick(element, mouseMsg)
+		i.discardMotion()
+
+		if tea.MouseEvent(mouseMsg).IsWheel() {
+			break
+		}
+
+		if i.Drag != nil && i.IsDragging {
+			// Lost Release
+			element, cmd = i.CancelDrag(element)
 			if cmd != nil {
 				cmds = append(cmds, cmd)
 			}
+		}
+
+		if i.Click != nil && isInside && mouseMsg.Button == tea.MouseButtonRight {
+			if i.IsEnabled(ClickCapability) {
+				// Right Click
+				element, cmd = i.Click.HandleRightClick(element, mouseMsg)
+				if cmd != nil {
+					cmds = append(cmds, cmd)
+				}
+			}
+		} else if i.Click != nil && isInside {
+			if i.IsEnabled(ClickCapability) {
+				// Click
+				element, cmd = i.Click.HandleClick(element, mouseMsg)
+				if cmd != nil {
+					cmds = append(cmds, cmd)
+				}
+				if i.isDoubleClick() {
+					// Double Click
+					element, cmd = i.Click.HandleDoubleClick(element, mouseMsg)
+					if cmd != nil {
+						cmds = append(cmds, cmd)
+					}
+				}
+			}
 
-			if i.Drag != nil {
+			if i.Drag != nil && i.IsEnabled(DragCapability) && mouseMsg.Button == tea.MouseButtonLeft {
 				i.IsDragging = true
 				element, cmd = i.Drag.HandleDragStart(element, mouseMsg)
 				if cmd != nil {
 					cmds = append(cmds, cmd)
 				}
+				i.LastDragActivity = time.Now()
+				if cmd = i.armDragWatchdog(i.DragCancelTimeout); cmd != nil {
+					cmds = append(cmds, cmd)
+				}
 			}
 		}
 
 	case tea.MouseActionRelease:
+		i.discardMotion()
+
 		if i.Drag != nil && i.IsDragging {
 			i.IsDragging = false
 			element, cmd = i.Drag.HandleDragEnd(element, mouseMsg)
@@ -100,26 +170,122 @@ func (i *Interactable) DefaultLocalHandler(element Interactive, mouseMsg tea.Mou
 	return element, tea.Batch(cmds...)
 }
 
+//* Hover Re-evaluation
+/*
+ - Re-tests a stationary pointer against the element after its layout has changed
+ - Produces a synthetic mouse enter or leave if the element has moved under or away from the pointer
+ - Unlike motion, hover and drag move handlers are not called
+*/
+func (i *Interactable) ReevaluateHover(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
+	if i.Hover == nil {
+		return element, nil
+	}
+
+	var cmd tea.Cmd
+	mouseMsg.Action = tea.MouseActionMotion
+	mouseMsg.Button = tea.MouseButtonNone
+	isInside := i.HandleIsInside(element, mouseMsg) && i.IsEnabled(HoverCapability)
+
+	switch {
+	case isInside && !i.IsHovered:
+		element, cmd = i.Hover.HandleMouseEnter(element, mouseMsg)
+	case !isInside && i.IsHovered:
+		element, cmd = i.Hover.HandleMouseLeave(element, mouseMsg)
+	}
+	return element, cmd
+}
+
+//* Interaction Release
+/*
+ - Clears hover and drag state for an element which is being removed, with a leave and a drag cancellation
+*/
+func (i *Interactable) Release(element Interactive) (Interactive, tea.Cmd) {
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+
+	element, cmd = i.CancelDrag(element)
+	if cmd != nil {
+		cmds = append(cmds, cmd)
+	}
+	if i.Hover != nil && i.IsHovered {
+		element, cmd = i.Hover.HandleMouseLeave(element, tea.MouseMsg{X: -1, Y: -1, Action: tea.MouseActionMotion})
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	interaction := element.GetInteraction()
+	interaction.IsHovered = false
+	interaction.discardMotion()
+
+	return element, tea.Batch(cmds...)
+}
+
+//* Double Click Assessment
+/*
+ - Records the press and returns true if it follows the previous press within DoubleClickThreshold
+ - DefaultDoubleClickThreshold is used if DoubleClickThreshold is not defined
+*/
+func (i *Interactable) isDoubleClick() bool {
+	threshold := i.DoubleClickThreshold
+	if threshold <= 0 {
+		threshold = DefaultDoubleClickThreshold
+	}
+
+	now := time.Now()
+	if i.ClickCount > 0 && now.Sub(i.LastClickTime) <= threshold {
+		i.ClickCount++
+	} else {
+		i.ClickCount = 1
+	}
+	i.LastClickTime = now
+
+	return i.ClickCount%2 == 0
+}
+
 //?--------------------------------------------------------------------------------------------------------------------
 
 //* External Event Handling
 /*
  - Responds to external event messages with ExternalHandler function or DefaultExternalHandler if it is not defined
+ - The message passes through the global and element middleware first, which may rewrite it or short-circuit
+ - Motion flushes and drag watchdogs are handled beforehand, so they work whichever handler and middleware are in use
 */
-func (i *Interactable) HandleExternalEvent(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
-	if i.LocalHandler != nil {
-		return i.ExternalHandler(element, mouseMsg)
+func (i *Interactable) HandleExternalEvent(element Interactive, msg tea.Msg) (Interactive, tea.Cmd) {
+	switch msg := msg.(type) {
+	case MotionFlush:
+		if msg.ID != i.ID {
+			return element, nil
+		}
+		return i.flushMotion(element, msg)
+	case DragWatchdog:
+		if msg.ID != i.ID || !msg.Time.Equal(i.watchdogAt) {
+			return element, nil
+		}
+		return i.invoke(element, msg, func(element Interactive, msg tea.Msg) (Interactive, tea.Cmd) {
+			return i.checkDragWatchdog(element)
+		})
 	}
-	return i.DefaultExternalHandler(element, mouseMsg)
+	return i.dispatch(element, msg, i.handleExternal)
 }
 
-//* External Event Handling
+func (i *Interactable) handleExternal(element Interactive, msg tea.Msg) (Interactive, tea.Cmd) {
+	if i.ExternalHandler != nil {
+		return i.ExternalHandler(element, msg)
+	}
+	return i.DefaultExternalHandler(element, msg)
+}
+
+//* Default External Handler
 /*
  - Interprets external event messages to direct the appropriate interaction handlers
+ - Escape key presses and focus loss cancel an in-progress drag
+ - Drag events from other elements are assessed against this element's bounds to drive the Drop handler
+ - Event handlers for disabled capabilities are skipped, and disabling drop while below a drag produces a leave
 */
 func (i *Interactable) DefaultExternalHandler(element Interactive, msg tea.Msg) (Interactive, tea.Cmd) {
 
-	if i.ClickEvent == nil && i.HoverEvent == nil && i.DragEvent == nil && i.DropEvent == nil {
+	if i.ClickEvent == nil && i.HoverEvent == nil && i.DragEvent == nil && i.DropEvent == nil &&
+		i.Hover == nil && i.Drag == nil && i.Drop == nil {
 		return element, nil
 	}
 
@@ -127,8 +293,22 @@ func (i *Interactable) DefaultExternalHandler(element Interactive, msg tea.Msg)
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyEsc {
+			element, cmd = i.CancelDrag(element)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+
+	case tea.BlurMsg:
+		element, cmd = i.CancelDrag(element)
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
 	case ClickEvent:
-		if i.ClickEvent != nil {
+		if i.ClickEvent != nil && i.IsEnabled(ClickCapability) {
 			switch msg.EventType {
 			case Click:
 				element, cmd = i.ClickEvent.HandleClickEvent(element, msg)
@@ -143,7 +323,7 @@ func (i *Interactable) DefaultExternalHandler(element Interactive, msg tea.Msg)
 		}
 
 	case HoverEvent:
-		if i.HoverEvent != nil {
+		if i.HoverEvent != nil && i.IsEnabled(HoverCapability) {
 			switch msg.EventType {
 			case MouseEnter:
 				element, cmd = i.HoverEvent.HandleMouseEnterEvent(element, msg)
@@ -158,7 +338,7 @@ func (i *Interactable) DefaultExternalHandler(element Interactive, msg tea.Msg)
 		}
 
 	case DragEvent:
-		if i.DragEvent != nil {
+		if i.DragEvent != nil && i.IsEnabled(DragCapability) {
 			switch msg.EventType {
 			case DragStart:
 				element, cmd = i.DragEvent.HandleDragStartEvent(element, msg)
@@ -166,14 +346,26 @@ func (i *Interactable) DefaultExternalHandler(element Interactive, msg tea.Msg)
 				element, cmd = i.DragEvent.HandleDragMoveEvent(element, msg)
 			case DragEnd:
 				element, cmd = i.DragEvent.HandleDragEndEvent(element, msg)
+			case DragCancel:
+				element, cmd = i.DragEvent.HandleDragCancelEvent(element, msg)
 			}
 			if cmd != nil {
 				cmds = append(cmds, cmd)
 			}
 		}
 
+		if i.Drop != nil && msg.ID != i.ID {
+			if !i.IsEnabled(DropCapability) {
+				msg.EventType = DragCancel
+			}
+			element, cmd = i.handleDropTarget(element, msg)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+
 	case DropEvent:
-		if i.DropEvent != nil {
+		if i.DropEvent != nil && i.IsEnabled(DropCapability) {
 			switch msg.EventType {
 			case DropEnter:
 				element, cmd = i.DropEvent.HandleDropEnterEvent(element, msg)
@@ -196,3 +388,110 @@ func (i *Interactable) DefaultExternalHandler(element Interactive, msg tea.Msg)
 
 	return element, tea.Batch(cmds...)
 }
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Drop Target Handling
+/*
+ - Directs drag events from other elements to the Drop handler based on the drag position
+ - Cancelled drags always produce a leave and restore the element's drop flags
+*/
+func (i *Interactable) handleDropTarget(element Interactive, dragEvent DragEvent) (Interactive, tea.Cmd) {
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+
+	switch dragEvent.EventType {
+	case DragStart, DragMove:
+		if i.HandleIsInside(element, dragEvent.MouseMsg) {
+			if !i.IsBelowDrop {
+				element, cmd = i.Drop.HandleDropEnter(element, dragEvent)
+				if cmd != nil {
+					cmds = append(cmds, cmd)
+				}
+			}
+			element, cmd = i.Drop.HandleDropHover(element, dragEvent)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		} else if i.IsBelowDrop {
+			element, cmd = i.Drop.HandleDropLeave(element, dragEvent)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+
+	case DragEnd:
+		if i.HandleIsInside(element, dragEvent.MouseMsg) {
+			element, cmd = i.Drop.HandleDropRelease(element, dragEvent)
+		} else if i.IsBelowDrop {
+			element, cmd = i.Drop.HandleDropLeave(element, dragEvent)
+		}
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
+	case DragCancel:
+		if i.IsBelowDrop {
+			element, cmd = i.Drop.HandleDropLeave(element, dragEvent)
+			if cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+			interaction := element.GetInteraction()
+			interaction.IsBelowDrop = false
+			interaction.IsValidDrop = false
+		}
+	}
+
+	return element, tea.Batch(cmds...)
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Drag Cancellation
+/*
+ - Aborts an in-progress drag, clearing IsDragging and calling the Drag handler's HandleDragCancel
+ - Does nothing if the element has no Drag handler or is not dragging
+*/
+func (i *Interactable) CancelDrag(element Interactive) (Interactive, tea.Cmd) {
+	if i.Drag == nil || !i.IsDragging {
+		return element, nil
+	}
+	i.IsDragging = false
+	return i.Drag.HandleDragCancel(element, i.DragPosition())
+}
+
+//* Drag Watchdog Scheduling
+/*
+ - Arms the drag's single DragWatchdog to arrive after the delay if DragCancelTimeout is set
+ - Arming replaces any previous watchdog, which is then ignored when it arrives
+*/
+func (i *Interactable) armDragWatchdog(delay time.Duration) tea.Cmd {
+	if i.DragCancelTimeout <= 0 {
+		return nil
+	}
+
+	id := i.ID
+	armed := time.Now()
+	i.watchdogAt = armed
+
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return DragWatchdog{ID: id, Time: armed}
+	})
+}
+
+//* Drag Watchdog Assessment
+/*
+ - Cancels the drag if it has been idle for DragCancelTimeout, otherwise re-arms for the remaining time
+*/
+func (i *Interactable) checkDragWatchdog(element Interactive) (Interactive, tea.Cmd) {
+	if !i.IsDragging {
+		i.watchdogAt = time.Time{}
+		return element, nil
+	}
+	idle := time.Since(i.LastDragActivity)
+	if idle >= i.DragCancelTimeout {
+		i.watchdogAt = time.Time{}
+		return i.CancelDrag(element)
+	}
+	return element, i.armDragWatchdog(i.DragCancelTimeout - idle)
+}
@@ -0,0 +1,51 @@
+package teaspoon
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Handler Function
+/*
+ - Signature shared by the local mouse path and the external event path
+*/
+type HandlerFunc func(element Interactive, msg tea.Msg) (Interactive, tea.Cmd)
+
+//* Middleware
+/*
+ - Wraps a handler to add cross-cutting behaviour such as logging, throttling, permission checks or coordinate transforms
+ - Middleware may short-circuit by not calling next, rewrite the message passed to next, or batch additional commands
+ - The local path only handles mouse messages, so rewriting a mouse message into another type short-circuits it
+*/
+type Middleware func(next HandlerFunc) HandlerFunc
+
+//* Global Middleware
+/*
+ - Applied to every element before its own Middleware, with the first entry outermost
+*/
+var GlobalMiddleware []Middleware
+
+//* Use Global Middleware
+/*
+ - Appends middleware to GlobalMiddleware
+*/
+func UseMiddleware(middleware ...Middleware) {
+	GlobalMiddleware = append(GlobalMiddleware, middleware...)
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Middleware Chaining
+/*
+ - Wraps the handler with the element's Middleware, then GlobalMiddleware so it runs outermost
+*/
+func (i *Interactable) chain(handler HandlerFunc) HandlerFunc {
+	for j := len(i.Middleware) - 1; j >= 0; j-- {
+		handler = i.Middleware[j](handler)
+	}
+	for j := len(GlobalMiddleware) - 1; j >= 0; j-- {
+		handler = GlobalMiddleware[j](handler)
+	}
+	return handler
+}
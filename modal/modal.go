@@ -0,0 +1,364 @@
+package modal
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jordanella/teaspoon"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Dialog Button
+/*
+ - A button of the dialog, identified in results by its ID
+*/
+type Button struct {
+	ID    string
+	Label string
+}
+
+//* Dialog Result Event
+/*
+ - Emitted when the dialog closes, ButtonID and Index identify the activated button
+ - Dismissed is true and Index is -1 if the dialog was closed with Escape or a backdrop click instead
+*/
+type DialogResultEvent struct {
+	ID        string
+	ButtonID  string
+	Index     int
+	Dismissed bool
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Modal Styles
+/*
+ - Styles for the dialog box, its title and body, and its buttons
+*/
+type Styles struct {
+	Box     lipgloss.Style
+	Title   lipgloss.Style
+	Body    lipgloss.Style
+	Button  lipgloss.Style
+	Hovered lipgloss.Style
+	Focused lipgloss.Style
+}
+
+//* Default Modal Styles
+/*
+ - Returns a rounded box with a bold title and the focused button reversed
+*/
+func DefaultStyles() Styles {
+	button := lipgloss.NewStyle().Padding(0, 1).MarginLeft(1)
+	return Styles{
+		Box:     lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1),
+		Title:   lipgloss.NewStyle().Bold(true).MarginBottom(1),
+		Body:    lipgloss.NewStyle().MarginBottom(1),
+		Button:  button,
+		Hovered: button.Foreground(lipgloss.Color("#44aaaa")),
+		Focused: button.Reverse(true),
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Dialog Button Element
+/*
+ - Interactive button recording clicks for the dialog to resolve
+*/
+type button struct {
+	Button
+	clicked     bool
+	interaction *teaspoon.Interactable
+}
+
+func (b *button) GetInteraction() *teaspoon.Interactable {
+	return b.interaction
+}
+
+func newButton(config Button) *button {
+	return &button{
+		Button: config,
+		interaction: &teaspoon.Interactable{
+			ID:     zone.NewPrefix(),
+			Cursor: teaspoon.PointerCursor,
+			Hover:  &teaspoon.HoverHandler{},
+			Click: &teaspoon.ClickHandler{
+				OnClick: func(element teaspoon.Interactive, mouseMsg tea.MouseMsg) (teaspoon.Interactive, tea.Cmd) {
+					element.(*button).clicked = true
+					return element, nil
+				},
+			},
+		},
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Modal Model
+/*
+ - Dialog drawn centred over the base view with Layer or Compose, closing with a DialogResultEvent
+ - Tab, Shift+Tab, Left and Right move focus between buttons, Enter and Space activate the focused button
+ - While open every key is kept within the dialog, and Escape dismisses it if DismissOnEscape is true
+ - Pressing outside of the box dismisses the dialog if DismissOnBackdrop is true, and is otherwise ignored
+ - If Registry is set, opening registers the buttons at depth Z and traps mouse and key dispatch to them, so neither
+   reaches elements behind the dialog, and closing unregisters them and restores the previous focus
+ - The Registry then delivers mouse messages to the buttons and should be updated before the dialog, which resolves
+   their clicks, otherwise the parent should withhold mouse and key messages from other elements while IsOpen
+*/
+type Model struct {
+	Title             string
+	Body              string
+	DismissOnBackdrop bool
+	DismissOnEscape   bool
+	DefaultButton     int
+	ScreenWidth       int
+	ScreenHeight      int
+	Z                 int
+	Registry          *teaspoon.Registry
+	Styles            Styles
+
+	id      string
+	open    bool
+	focus   int
+	buttons []*button
+}
+
+//* Creation Method
+/*
+ - Returns a closed dialog with the given buttons, or a single OK button if none are given
+*/
+func New(title, body string, buttons ...Button) Model {
+	if len(buttons) == 0 {
+		buttons = []Button{{ID: "ok", Label: "OK"}}
+	}
+	m := Model{
+		Title:           title,
+		Body:            body,
+		DismissOnEscape: true,
+		ScreenWidth:     80,
+		ScreenHeight:    24,
+		Z:               100,
+		Styles:          DefaultStyles(),
+		id:              zone.NewPrefix(),
+	}
+	for _, config := range buttons {
+		m.buttons = append(m.buttons, newButton(config))
+	}
+	return m
+}
+
+//* Modal ID
+/*
+ - Returns the ID attached to the dialog's events
+*/
+func (m Model) ID() string {
+	return m.id
+}
+
+//* Is Open
+/*
+ - Returns true while the dialog is shown
+*/
+func (m Model) IsOpen() bool {
+	return m.open
+}
+
+//* Open
+/*
+ - Shows the dialog with DefaultButton focused, trapping the Registry's dispatch to its buttons if it is set
+ - The returned command releases the hover and drag state of elements behind the dialog
+*/
+func (m Model) Open() (Model, tea.Cmd) {
+	if m.open {
+		return m, nil
+	}
+	m.open = true
+
+	if m.Registry == nil {
+		m.focusButton(m.DefaultButton)
+		return m, nil
+	}
+	ids := make([]string, len(m.buttons))
+	for i, b := range m.buttons {
+		m.Registry.Register(b, nil)
+		m.Registry.SetZIndex(b.interaction.ID, m.Z)
+		ids[i] = b.interaction.ID
+	}
+	cmd := m.Registry.Trap(ids...)
+	m.focusButton(m.DefaultButton)
+	return m, cmd
+}
+
+//* Close
+/*
+ - Hides the dialog without emitting a result, lifting the Registry's trap and restoring its previous focus
+*/
+func (m Model) Close() Model {
+	if !m.open {
+		return m
+	}
+	m.open = false
+	for _, b := range m.buttons {
+		b.interaction.IsHovered = false
+		b.clicked = false
+	}
+	if m.Registry != nil {
+		m.Registry.Untrap()
+		for _, b := range m.buttons {
+			m.Registry.Unregister(b.interaction.ID)
+		}
+	}
+	return m
+}
+
+func (m *Model) focusButton(index int) {
+	m.focus = max(min(index, len(m.buttons)-1), 0)
+	if m.Registry != nil && len(m.buttons) > 0 {
+		m.Registry.Focus(m.buttons[m.focus].interaction.ID)
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Update Routine
+/*
+ - Tracks the screen size, and while open resolves button clicks, backdrop presses and key presses
+*/
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		m.ScreenWidth, m.ScreenHeight = size.Width, size.Height
+		return m, nil
+	}
+
+	if !m.open {
+		return m, nil
+	}
+
+	switch msg := msg.(type) {
+	case tea.MouseMsg:
+		if tea.MouseEvent(msg).IsWheel() {
+			return m, nil
+		}
+		if msg.Action == tea.MouseActionPress && !zone.Get(m.id).InBounds(msg) {
+			if m.DismissOnBackdrop {
+				return m.result(-1)
+			}
+			return m, nil
+		}
+
+		var cmd tea.Cmd
+		var cmds []tea.Cmd
+		if m.Registry == nil {
+			for _, b := range m.buttons {
+				if _, cmd = b.interaction.HandleMouseMsg(b, msg); cmd != nil {
+					cmds = append(cmds, cmd)
+				}
+			}
+		}
+		for i, b := range m.buttons {
+			if b.clicked {
+				b.clicked = false
+				m, cmd = m.result(i)
+				return m, tea.Batch(append(cmds, cmd)...)
+			}
+		}
+		return m, tea.Batch(cmds...)
+
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyTab, tea.KeyRight:
+			m.focusButton((m.focus + 1) % len(m.buttons))
+		case tea.KeyShiftTab, tea.KeyLeft:
+			m.focusButton((m.focus + len(m.buttons) - 1) % len(m.buttons))
+		case tea.KeyEnter, tea.KeySpace:
+			return m.result(m.focus)
+		case tea.KeyEsc:
+			if m.DismissOnEscape {
+				return m.result(-1)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+//* Dialog Result
+/*
+ - Closes the dialog and emits a DialogResultEvent for the button at index, or a dismissal if index is -1
+*/
+func (m Model) result(index int) (Model, tea.Cmd) {
+	resultEvent := DialogResultEvent{ID: m.id, Index: index, Dismissed: index < 0}
+	if index >= 0 {
+		resultEvent.ButtonID = m.buttons[index].ID
+	}
+	m = m.Close()
+	return m, func() tea.Msg {
+		return resultEvent
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Modal View
+/*
+ - Renders the dialog box, or an empty string while closed
+*/
+func (m Model) View() string {
+	if !m.open {
+		return ""
+	}
+
+	var buttons []string
+	for i, b := range m.buttons {
+		style := m.Styles.Button
+		switch {
+		case i == m.focus:
+			style = m.Styles.Focused
+		case b.interaction.IsHovered:
+			style = m.Styles.Hovered
+		}
+		buttons = append(buttons, zone.Mark(b.interaction.ID, style.Render(b.Label)))
+	}
+
+	var sections []string
+	if m.Title != "" {
+		sections = append(sections, m.Styles.Title.Render(m.Title))
+	}
+	if m.Body != "" {
+		sections = append(sections, m.Styles.Body.Render(m.Body))
+	}
+	sections = append(sections, strings.Join(buttons, ""))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, sections...)
+	return zone.Mark(m.id, m.Styles.Box.Render(content))
+}
+
+//* Modal Layer
+/*
+ - Returns the dialog centred within ScreenWidth and ScreenHeight at depth Z, ready for teaspoon.Compose
+*/
+func (m Model) Layer() teaspoon.Layer {
+	view := m.View()
+	width, height := lipgloss.Size(view)
+	return teaspoon.Layer{
+		View: view,
+		X:    max((m.ScreenWidth-width)/2, 0),
+		Y:    max((m.ScreenHeight-height)/2, 0),
+		Z:    m.Z,
+	}
+}
+
+//* Compose Over Base
+/*
+ - Draws the dialog over the base view if it is open, otherwise returns the base view unchanged
+*/
+func (m Model) Compose(base string) string {
+	if !m.open {
+		return base
+	}
+	return teaspoon.Compose(base, m.Layer())
+}
@@ -0,0 +1,94 @@
+package modal
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jordanella/teaspoon"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+type background struct {
+	clicks      int
+	keys        int
+	interaction *teaspoon.Interactable
+}
+
+func (b *background) GetInteraction() *teaspoon.Interactable {
+	return b.interaction
+}
+
+// Returns an element covering the whole screen which counts the clicks and keys it receives
+func newBackground() *background {
+	b := &background{}
+	b.interaction = &teaspoon.Interactable{
+		ID:    "background",
+		Hover: &teaspoon.HoverHandler{},
+		Click: &teaspoon.ClickHandler{
+			OnClick: func(element teaspoon.Interactive, mouseMsg tea.MouseMsg) (teaspoon.Interactive, tea.Cmd) {
+				element.(*background).clicks++
+				return element, nil
+			},
+		},
+		IsInside: func(teaspoon.Interactive, tea.Msg) bool { return true },
+		ExternalHandler: func(element teaspoon.Interactive, msg tea.Msg) (teaspoon.Interactive, tea.Cmd) {
+			if _, ok := msg.(tea.KeyMsg); ok {
+				element.(*background).keys++
+			}
+			return element, nil
+		},
+	}
+	return b
+}
+
+func press(x, y int) tea.MouseMsg {
+	return tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft}
+}
+
+func TestModalTrap(t *testing.T) {
+	zone.NewGlobal()
+	registry := teaspoon.NewRegistry()
+	behind := newBackground()
+	registry.Register(behind, nil)
+
+	m := New("Delete", "Delete the file?", Button{ID: "cancel", Label: "Cancel"}, Button{ID: "delete", Label: "Delete"})
+	m.Registry = registry
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 40, Height: 12})
+
+	registry.Update(tea.MouseMsg{X: 1, Y: 1, Action: tea.MouseActionMotion})
+	m, _ = m.Open()
+	if behind.interaction.IsHovered {
+		t.Error("element behind the dialog still hovered once it opened")
+	}
+
+	deleteID := m.buttons[1].interaction.ID
+	zone.Scan(m.Compose(strings.Repeat(strings.Repeat(" ", 40)+"\n", 11) + strings.Repeat(" ", 40)))
+	for deadline := time.Now().Add(time.Second); zone.Get(deleteID).IsZero(); time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("zones were not stored")
+		}
+	}
+
+	for _, msg := range []tea.Msg{press(0, 0), tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")}} {
+		registry.Update(msg)
+		m, _ = m.Update(msg)
+	}
+	if behind.clicks != 0 || behind.keys != 0 || !m.IsOpen() {
+		t.Errorf("behind the open dialog %d clicks and %d keys were received, dialog open %v", behind.clicks, behind.keys, m.IsOpen())
+	}
+
+	bounds := zone.Get(deleteID)
+	registry.Update(press(bounds.StartX, bounds.StartY))
+	m, cmd := m.Update(press(bounds.StartX, bounds.StartY))
+	want := DialogResultEvent{ID: m.id, ButtonID: "delete", Index: 1}
+	if cmd == nil || cmd() != want || m.IsOpen() {
+		t.Fatalf("pressing Delete left the dialog open %v, want result %+v", m.IsOpen(), want)
+	}
+
+	registry.Update(press(0, 0))
+	if behind.clicks != 1 {
+		t.Errorf("element behind the closed dialog received %d clicks, want 1", behind.clicks)
+	}
+}
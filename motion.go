@@ -0,0 +1,96 @@
+package teaspoon
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Frame Interval
+/*
+ - Convenient MotionInterval limiting hover and drag move handling to roughly one per rendered frame
+*/
+const FrameInterval = time.Second / 60
+
+//* Motion Flush
+/*
+ - Scheduled message delivering the latest motion held back by an element's MotionInterval
+*/
+type MotionFlush struct {
+	ID   string
+	Time time.Time
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Motion Coalescing
+/*
+ - Returns true if hover and drag move handlers should run for the motion message
+ - Without a MotionInterval every motion is delivered
+ - Otherwise motion within the last delivered cell is discarded, and motion arriving within the interval is held back
+ - Returning to the last delivered cell also drops any held motion, so a stale position is never flushed
+ - The first held motion schedules a MotionFlush, later ones replace it, so the final position is always delivered
+ - CoalescedMotions records how many held motions were merged into the latest delivered one
+*/
+func (i *Interactable) coalesceMotion(mouseMsg tea.MouseMsg) (bool, tea.Cmd) {
+	if i.MotionInterval <= 0 {
+		return true, nil
+	}
+
+	position := Point{X: mouseMsg.X, Y: mouseMsg.Y}
+	if !i.lastMotionAt.IsZero() && position == i.lastMotion {
+		i.discardMotion()
+		return false, nil
+	}
+
+	now := time.Now()
+	elapsed := now.Sub(i.lastMotionAt)
+	if elapsed >= i.MotionInterval {
+		i.lastMotion = position
+		i.lastMotionAt = now
+		i.CoalescedMotions = i.pendingCount
+		i.pendingMotion = nil
+		i.pendingCount = 0
+		return true, nil
+	}
+
+	first := i.pendingMotion == nil
+	i.pendingMotion = &mouseMsg
+	i.pendingCount++
+	if !first {
+		return false, nil
+	}
+
+	id := i.ID
+	i.pendingAt = now
+	return false, tea.Tick(i.MotionInterval-elapsed, func(time.Time) tea.Msg {
+		return MotionFlush{ID: id, Time: now}
+	})
+}
+
+//* Discard Held Motion
+/*
+ - Drops held motion superseded by a press, a release or a return to the last delivered cell
+*/
+func (i *Interactable) discardMotion() {
+	i.pendingMotion = nil
+	i.pendingCount = 0
+}
+
+//* Motion Flushing
+/*
+ - Delivers the held motion to the local handler once its interval has passed
+ - The held motion already passed through the middleware when it arrived, so it is not run through it again
+ - Flushes scheduled for motion which has since been delivered or discarded are ignored
+*/
+func (i *Interactable) flushMotion(element Interactive, flush MotionFlush) (Interactive, tea.Cmd) {
+	if i.pendingMotion == nil || !i.pendingAt.Equal(flush.Time) {
+		return element, nil
+	}
+	mouseMsg := *i.pendingMotion
+	i.pendingCount--
+	i.lastMotionAt = time.Time{}
+	return i.invoke(element, mouseMsg, i.handleLocal)
+}
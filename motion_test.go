@@ -0,0 +1,159 @@
+package teaspoon
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestCoalesceMotion(t *testing.T) {
+	type step struct {
+		x, y    int
+		deliver bool
+		flush   bool
+		pending int
+	}
+
+	tests := []struct {
+		name     string
+		interval time.Duration
+		steps    []step
+	}{
+		{
+			name: "without interval",
+			steps: []step{
+				{x: 1, y: 1, deliver: true},
+				{x: 1, y: 1, deliver: true},
+				{x: 2, y: 1, deliver: true},
+			},
+		},
+		{
+			name:     "same cell discarded",
+			interval: time.Hour,
+			steps: []step{
+				{x: 1, y: 1, deliver: true},
+				{x: 1, y: 1},
+			},
+		},
+		{
+			name:     "held within interval",
+			interval: time.Hour,
+			steps: []step{
+				{x: 1, y: 1, deliver: true},
+				{x: 2, y: 1, flush: true, pending: 1},
+				{x: 3, y: 1, pending: 2},
+				{x: 4, y: 2, pending: 3},
+			},
+		},
+		{
+			name:     "return to delivered cell drops held motion",
+			interval: time.Hour,
+			steps: []step{
+				{x: 1, y: 1, deliver: true},
+				{x: 2, y: 1, flush: true, pending: 1},
+				{x: 1, y: 1},
+				{x: 2, y: 1, flush: true, pending: 1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			i := &Interactable{ID: "a", MotionInterval: test.interval}
+			for n, step := range test.steps {
+				deliver, cmd := i.coalesceMotion(tea.MouseMsg{X: step.x, Y: step.y, Action: tea.MouseActionMotion})
+				if deliver != step.deliver || (cmd != nil) != step.flush || i.pendingCount != step.pending {
+					t.Errorf("step %d: delivered %v, flush scheduled %v, %d pending, want %v, %v, %d",
+						n, deliver, cmd != nil, i.pendingCount, step.deliver, step.flush, step.pending)
+				}
+				if (i.pendingMotion != nil) != (step.pending > 0) {
+					t.Errorf("step %d: held motion %v with %d pending", n, i.pendingMotion, i.pendingCount)
+				} else if i.pendingMotion != nil && (i.pendingMotion.X != step.x || i.pendingMotion.Y != step.y) {
+					t.Errorf("step %d: held motion at %d,%d", n, i.pendingMotion.X, i.pendingMotion.Y)
+				}
+			}
+		})
+	}
+}
+
+func TestMotionFlush(t *testing.T) {
+	element := newTestElement("a")
+	i := element.interaction
+	i.MotionInterval = time.Hour
+	i.IsInside = func(Interactive, tea.Msg) bool { return true }
+
+	i.HandleMouseMsg(element, tea.MouseMsg{X: 1, Y: 1, Action: tea.MouseActionMotion})
+	i.HandleMouseMsg(element, tea.MouseMsg{X: 2, Y: 1, Action: tea.MouseActionMotion})
+	i.HandleMouseMsg(element, tea.MouseMsg{X: 3, Y: 1, Action: tea.MouseActionMotion})
+	flushAt := i.pendingAt
+
+	tests := []struct {
+		name      string
+		flush     MotionFlush
+		delivered Point
+		coalesced int
+	}{
+		{name: "stale flush ignored", flush: MotionFlush{ID: "a", Time: flushAt.Add(-time.Second)}, delivered: Point{X: 1, Y: 1}},
+		{name: "latest position delivered", flush: MotionFlush{ID: "a", Time: flushAt}, delivered: Point{X: 3, Y: 1}, coalesced: 1},
+		{name: "repeated flush ignored", flush: MotionFlush{ID: "a", Time: flushAt}, delivered: Point{X: 3, Y: 1}, coalesced: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			i.flushMotion(element, test.flush)
+			if i.lastMotion != test.delivered || i.CoalescedMotions != test.coalesced {
+				t.Errorf("delivered %v with %d coalesced, want %v with %d", i.lastMotion, i.CoalescedMotions, test.delivered, test.coalesced)
+			}
+		})
+	}
+}
+
+func TestMotionFlushDelivery(t *testing.T) {
+	tests := []struct {
+		name      string
+		inside    bool
+		external  bool
+		scheduled bool
+	}{
+		{name: "default handler", inside: true, scheduled: true},
+		{name: "custom external handler", inside: true, external: true, scheduled: true},
+		{name: "not hovered", inside: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			element := newTestElement("a")
+			i := element.interaction
+			i.MotionInterval = time.Hour
+			i.IsInside = func(Interactive, tea.Msg) bool { return test.inside }
+			if test.external {
+				i.ExternalHandler = func(element Interactive, msg tea.Msg) (Interactive, tea.Cmd) {
+					return element, nil
+				}
+			}
+			passes := 0
+			i.Middleware = []Middleware{func(next HandlerFunc) HandlerFunc {
+				return func(element Interactive, msg tea.Msg) (Interactive, tea.Cmd) {
+					passes++
+					return next(element, msg)
+				}
+			}}
+
+			i.HandleMouseMsg(element, tea.MouseMsg{X: 1, Y: 1, Action: tea.MouseActionMotion})
+			_, cmd := i.HandleMouseMsg(element, tea.MouseMsg{X: 2, Y: 1, Action: tea.MouseActionMotion})
+			if (cmd != nil) != test.scheduled {
+				t.Fatalf("flush scheduled %v, want %v", cmd != nil, test.scheduled)
+			}
+			if !test.scheduled {
+				return
+			}
+
+			passes = 0
+			i.HandleExternalEvent(element, MotionFlush{ID: "a", Time: i.pendingAt})
+			if i.lastMotion != (Point{X: 2, Y: 1}) || passes != 0 {
+				t.Errorf("delivered %v with %d middleware passes, want 2,1 with none", i.lastMotion, passes)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package teaspoon
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Pressable Element
+/*
+ - Interactive part of a component recording presses for the component to resolve, such as a slider's track or thumb
+ - Draggable parts are also given Hover and Drag handlers
+*/
+type Pressable struct {
+	pressed     bool
+	pressMsg    tea.MouseMsg
+	interaction *Interactable
+}
+
+//* Creation Method
+/*
+ - Returns a new pressable part with its own zone ID, optionally hoverable and draggable
+*/
+func NewPressable(draggable bool) *Pressable {
+	press := func(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
+		p := element.(*Pressable)
+		p.pressed = true
+		p.pressMsg = mouseMsg
+		return element, nil
+	}
+
+	p := &Pressable{
+		interaction: &Interactable{
+			ID: zone.NewPrefix(),
+			Click: &ClickHandler{
+				OnClick: press,
+			},
+		},
+	}
+	if draggable {
+		p.interaction.Hover = &HoverHandler{}
+		p.interaction.Drag = &DragHandler{}
+	}
+	return p
+}
+
+//* Implement Interactive Interface
+/*
+ - Provides reference to the part's interaction
+*/
+func (p *Pressable) GetInteraction() *Interactable {
+	return p.interaction
+}
+
+//* Take Press
+/*
+ - Returns the press recorded since the last call and clears it, reporting false if there was none
+*/
+func (p *Pressable) TakePress() (tea.MouseMsg, bool) {
+	pressMsg, pressed := p.pressMsg, p.pressed
+	p.pressed, p.pressMsg = false, tea.MouseMsg{}
+	return pressMsg, pressed
+}
@@ -0,0 +1,150 @@
+package teaspoon
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Interaction Errors
+/*
+ - Emitted in safe dispatch mode when a handler panics or returns an invalid element
+ - Phase names the innermost Handle method running when the failure occurred, such as HandleClick or HandleIsInside
+ - Stack is only captured for panics
+*/
+type InteractionError struct {
+	ID    string
+	Phase string
+	Err   error
+	Stack []byte
+}
+
+func (e InteractionError) Error() string {
+	return fmt.Sprintf("teaspoon: %s failed in %s: %v", e.ID, e.Phase, e.Err)
+}
+
+func (e InteractionError) Unwrap() error {
+	return e.Err
+}
+
+//* Global Safe Dispatch
+/*
+ - Enables safe dispatch for every element regardless of its SafeDispatch property
+*/
+var GlobalSafeDispatch bool
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Dispatch
+/*
+ - Runs the handler through the middleware chain, recovering failures if safe dispatch is enabled
+*/
+func (i *Interactable) dispatch(element Interactive, msg tea.Msg, handler HandlerFunc) (Interactive, tea.Cmd) {
+	return i.invoke(element, msg, i.chain(handler))
+}
+
+//* Unchained Dispatch
+/*
+ - Runs the handler directly, recovering failures if safe dispatch is enabled
+ - Used for messages an element scheduled for itself, which have no place in the middleware chain
+*/
+func (i *Interactable) invoke(element Interactive, msg tea.Msg, handler HandlerFunc) (Interactive, tea.Cmd) {
+	if !i.SafeDispatch && !GlobalSafeDispatch {
+		return handler(element, msg)
+	}
+	return i.safely(element, msg, handler)
+}
+
+//* Safe Dispatch
+/*
+ - Recovers panics in the handler and rejects results which are nil or of a different concrete type than the element
+ - On failure the interaction state is restored, the original element is returned and an InteractionError is emitted
+ - Commands returned by the handler are wrapped so panics while they run are reported the same way
+*/
+func (i *Interactable) safely(element Interactive, msg tea.Msg, handler HandlerFunc) (result Interactive, cmd tea.Cmd) {
+	previous := *i
+	id := i.ID
+
+	fail := func(interactionError InteractionError) {
+		*i = previous
+		if interaction := element.GetInteraction(); interaction != i {
+			*interaction = previous
+		}
+		result = element
+		cmd = func() tea.Msg {
+			return interactionError
+		}
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			fail(InteractionError{
+				ID:    id,
+				Phase: panicPhase(),
+				Err:   fmt.Errorf("panic: %v", recovered),
+				Stack: debug.Stack(),
+			})
+		}
+	}()
+
+	result, cmd = handler(element, msg)
+
+	if result == nil || reflect.TypeOf(result) != reflect.TypeOf(element) {
+		phase := "HandleExternalEvent"
+		if _, ok := msg.(tea.MouseMsg); ok {
+			phase = "HandleMouseMsg"
+		}
+		fail(InteractionError{
+			ID:    id,
+			Phase: phase,
+			Err:   fmt.Errorf("handler returned %T, expected %T", result, element),
+		})
+		return result, cmd
+	}
+
+	return result, safeCmd(id, cmd)
+}
+
+func safeCmd(id string, cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() (msg tea.Msg) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				msg = InteractionError{
+					ID:    id,
+					Phase: "Command",
+					Err:   fmt.Errorf("panic: %v", recovered),
+					Stack: debug.Stack(),
+				}
+			}
+		}()
+		return cmd()
+	}
+}
+
+//* Panic Phase
+/*
+ - Walks the panicking stack to find the innermost Handle method, naming the phase which failed
+*/
+func panicPhase() string {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		name := frame.Function[strings.LastIndex(frame.Function, ".")+1:]
+		if strings.HasPrefix(name, "Handle") {
+			return name
+		}
+		if !more {
+			return "Unknown"
+		}
+	}
+}
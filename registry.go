@@ -0,0 +1,610 @@
+package teaspoon
+
+import (
+	"sort"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Element Registry
+/*
+ - Holds interactive elements of any concrete type and dispatches messages to all of them with a single Update
+ - Mouse messages are passed to HandleMouseMsg and all other messages to HandleExternalEvent
+ - Handled elements are written back through the setter given at registration, so value types stay current
+ - Elements are dispatched from the highest z-index down, with the focused element first among equals
+ - Dispatch order is maintained as elements are registered, focused or given a z-index, not sorted per message
+ - With a hit index, mouse messages only reach elements beneath the pointer and those hovered, dragging, beneath a
+   drag or holding back motion, which must observe it leaving
+ - Elements with their own IsInside cannot be indexed and always receive mouse messages
+ - Middleware and local handlers only run for the elements a mouse message is dispatched to
+ - The last pointer position is re-tested after window resizes or Reevaluate, correcting stale hover state
+ - If Cursor is set the pointer shape is updated after each dispatch from the elements observing the pointer
+ - Traps restrict mouse and key dispatch to a subset of elements, such as the buttons of a modal
+ - Layers recorded with SetLayers occlude the zones of lower elements, so overlays are hit tested as they are drawn
+ - While an element holds pointer capture it alone receives mouse messages, wherever the pointer is
+ - A dragging element captures the pointer automatically until its drag ends or is cancelled
+*/
+type Registry struct {
+	Cursor *CursorController
+
+	entries   []*registryEntry
+	byID      map[string]*registryEntry
+	active    map[string]*registryEntry
+	unindexed map[string]*registryEntry
+	sequence  int
+	focused   string
+	index     *HitIndex
+	pointer   *tea.MouseMsg
+	traps     []registryTrap
+	capture   string
+	dragged   bool
+	layers    []occludingLayer
+}
+
+type occludingLayer struct {
+	bounds Rect
+	z      int
+}
+
+type registryTrap struct {
+	ids     map[string]bool
+	focused string
+}
+
+//* Hover Re-evaluation Message
+/*
+ - Scheduled by Reevaluate to re-test the last pointer position once the new layout has been rendered
+*/
+type HoverReevaluation struct{}
+
+type registryEntry struct {
+	element Interactive
+	set     func(element Interactive)
+	z       int
+	seq     int
+	rank    int
+}
+
+//* Creation Method
+/*
+ - Returns a new empty registry
+*/
+func NewRegistry() *Registry {
+	return &Registry{
+		byID:      map[string]*registryEntry{},
+		active:    map[string]*registryEntry{},
+		unindexed: map[string]*registryEntry{},
+	}
+}
+
+//* Register Element
+/*
+ - Adds an element at z-index 0, replacing any element registered with the same ID
+ - The setter is called with the handled element after each dispatch and may be nil for pointer types
+*/
+func (r *Registry) Register(element Interactive, set func(element Interactive)) {
+	if r.byID == nil {
+		r.byID = map[string]*registryEntry{}
+		r.active = map[string]*registryEntry{}
+		r.unindexed = map[string]*registryEntry{}
+	}
+
+	id := element.GetInteraction().ID
+	entry := r.entry(id)
+	if entry != nil {
+		entry.element, entry.set = element, set
+		element.GetInteraction().registry = r
+	} else {
+		r.sequence++
+		entry = &registryEntry{element: element, set: set, seq: r.sequence, rank: -1}
+		r.byID[id] = entry
+		r.place(entry)
+		element.GetInteraction().registry = r
+		if r.index != nil {
+			r.index.Track(id)
+		}
+	}
+
+	if element.GetInteraction().IsInside != nil {
+		r.unindexed[id] = entry
+	} else {
+		delete(r.unindexed, id)
+	}
+	r.track(entry)
+}
+
+//* Unregister Element
+/*
+ - Removes the element with the given ID, clearing focus if it was focused
+ - The element's hover and drag state is released, returning any leave and drag cancellation commands
+*/
+func (r *Registry) Unregister(id string) tea.Cmd {
+	var cmd tea.Cmd
+	if entry := r.entry(id); entry != nil {
+		element := entry.element
+		element, cmd = element.GetInteraction().Release(element)
+		element.GetInteraction().registry = nil
+		if entry.set != nil {
+			entry.set(element)
+		}
+		r.entries = append(r.entries[:entry.rank:entry.rank], r.entries[entry.rank+1:]...)
+		r.rerank()
+		delete(r.byID, id)
+		delete(r.active, id)
+		delete(r.unindexed, id)
+	}
+	if r.focused == id {
+		r.focused = ""
+	}
+	if r.capture == id {
+		r.capture, r.dragged = "", false
+	}
+	if r.index != nil {
+		r.index.Untrack(id)
+	}
+	return cmd
+}
+
+//* Registered Element
+/*
+ - Returns the current state of the element with the given ID, or nil if it is not registered
+*/
+func (r *Registry) Element(id string) Interactive {
+	if entry := r.entry(id); entry != nil {
+		return entry.element
+	}
+	return nil
+}
+
+//* Registered Elements
+/*
+ - Returns every registered element in dispatch order
+*/
+func (r *Registry) Elements() []Interactive {
+	elements := make([]Interactive, 0, len(r.entries))
+	for _, entry := range r.entries {
+		elements = append(elements, entry.element)
+	}
+	return elements
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Set Z-Index
+/*
+ - Sets the z-index of the element with the given ID, higher values are dispatched first
+*/
+func (r *Registry) SetZIndex(id string, z int) {
+	if entry := r.entry(id); entry != nil && entry.z != z {
+		entry.z = z
+		r.place(entry)
+	}
+}
+
+//* Z-Index
+/*
+ - Returns the z-index of the element with the given ID, or 0 if it is not registered
+*/
+func (r *Registry) ZIndex(id string) int {
+	if entry := r.entry(id); entry != nil {
+		return entry.z
+	}
+	return 0
+}
+
+//* Focus Element
+/*
+ - Focuses the element with the given ID so it is dispatched before others at its z-index, an empty ID clears focus
+*/
+func (r *Registry) Focus(id string) {
+	previous := r.focused
+	r.focused = id
+	if entry := r.entry(previous); entry != nil {
+		r.place(entry)
+	}
+	if entry := r.entry(id); entry != nil {
+		r.place(entry)
+	}
+}
+
+//* Focused Element
+/*
+ - Returns the ID of the focused element, or an empty string if none is focused
+*/
+func (r *Registry) Focused() string {
+	return r.focused
+}
+
+//* Set Pointer Capture
+/*
+ - Routes every mouse message to the element with the given ID, and no others, until the capture is released
+*/
+func (r *Registry) SetPointerCapture(id string) {
+	r.capture, r.dragged = id, false
+}
+
+//* Release Pointer Capture
+/*
+ - Releases the capture if it is held by the element with the given ID
+*/
+func (r *Registry) ReleasePointerCapture(id string) {
+	if r.capture == id {
+		r.capture, r.dragged = "", false
+	}
+}
+
+//* Pointer Capture
+/*
+ - Returns the ID of the element holding pointer capture, or an empty string if the pointer is not captured
+*/
+func (r *Registry) PointerCapture() string {
+	return r.capture
+}
+
+//* Trap Dispatch
+/*
+ - Restricts mouse and key dispatch to the elements with the given IDs until Untrap, with no IDs blocking every element
+ - Other messages, such as drag events and ticks, still reach every element
+ - Blocked elements have their hover and drag state released, and the current focus is saved for Untrap
+ - Traps nest, so a dialog opened from a dialog traps within the first
+*/
+func (r *Registry) Trap(ids ...string) tea.Cmd {
+	trap := registryTrap{ids: map[string]bool{}, focused: r.focused}
+	for _, id := range ids {
+		trap.ids[id] = true
+	}
+	r.traps = append(r.traps, trap)
+	if r.capture != "" && !trap.ids[r.capture] {
+		r.capture, r.dragged = "", false
+	}
+
+	var cmds []tea.Cmd
+	for _, entry := range r.entries {
+		if !r.dispatchable(entry) {
+			element, cmd := entry.element.GetInteraction().Release(entry.element)
+			entry.element = element
+			if entry.set != nil {
+				entry.set(element)
+			}
+			r.track(entry)
+			cmds = append(cmds, cmd)
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+//* Untrap Dispatch
+/*
+ - Lifts the most recent trap and restores the focus saved when it was set
+*/
+func (r *Registry) Untrap() {
+	if len(r.traps) == 0 {
+		return
+	}
+	focused := r.traps[len(r.traps)-1].focused
+	r.traps = r.traps[:len(r.traps)-1]
+	r.Focus(focused)
+}
+
+//* Is Trapped
+/*
+ - Returns true if mouse and key dispatch is currently restricted by a trap
+*/
+func (r *Registry) IsTrapped() bool {
+	return len(r.traps) > 0
+}
+
+func (r *Registry) dispatchable(entry *registryEntry) bool {
+	if len(r.traps) == 0 {
+		return true
+	}
+	return r.traps[len(r.traps)-1].ids[entry.element.GetInteraction().ID]
+}
+
+//* Set Composed Layers
+/*
+ - Records the layers drawn over the base view with Compose, to be called from View with the same layers
+ - An element is outside wherever a layer with a greater Z than its z-index covers the cell, even within its zone
+ - Elements drawn within a layer should be given a z-index of at least the layer's Z, such as a dialog's buttons
+ - Calling without layers lifts the occlusion once overlays are removed
+*/
+func (r *Registry) SetLayers(layers ...Layer) {
+	r.layers = r.layers[:0]
+	for _, layer := range layers {
+		width, height := lipgloss.Size(layer.View)
+		if width == 0 || height == 0 {
+			continue
+		}
+		bounds := Rect{
+			Min: Point{X: layer.X, Y: layer.Y},
+			Max: Point{X: layer.X + width - 1, Y: layer.Y + height - 1},
+		}
+		r.layers = append(r.layers, occludingLayer{bounds: bounds, z: layer.Z})
+	}
+}
+
+//* Occlusion Assessment
+/*
+ - Returns true if a recorded layer above the element with the given ID covers the cell
+*/
+func (r *Registry) IsOccluded(id string, x, y int) bool {
+	entry := r.entry(id)
+	if entry == nil {
+		return false
+	}
+	for _, layer := range r.layers {
+		if layer.z > entry.z && layer.bounds.Intersects(Rect{Min: Point{X: x, Y: y}, Max: Point{X: x, Y: y}}) {
+			return true
+		}
+	}
+	return false
+}
+
+//* Use Hit Index
+/*
+ - Indexes the bounds of registered elements so mouse messages are only dispatched to candidates
+ - Invalidate must then be called whenever the view is rendered
+*/
+func (r *Registry) UseHitIndex(cellSize int) {
+	r.index = NewHitIndex()
+	r.index.CellSize = cellSize
+	for _, entry := range r.entries {
+		r.index.Track(entry.element.GetInteraction().ID)
+	}
+}
+
+//* Invalidate Hit Index
+/*
+ - Marks the given elements, or every element if no IDs are given, for refresh on the next mouse message
+ - To be called from View
+*/
+func (r *Registry) Invalidate(ids ...string) {
+	if r.index != nil {
+		r.index.Invalidate(ids...)
+	}
+}
+
+//* Schedule Hover Re-evaluation
+/*
+ - Returns a command re-testing the last pointer position after the next frame, for use after scrolling or relayout
+*/
+func (r *Registry) Reevaluate() tea.Cmd {
+	return tea.Tick(FrameInterval, func(time.Time) tea.Msg {
+		return HoverReevaluation{}
+	})
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Update Routine
+/*
+ - Dispatches the message to every registered element in order and returns the batched commands
+ - Window size messages schedule a hover re-evaluation, which is performed instead of dispatch when it arrives
+*/
+func (r *Registry) Update(msg tea.Msg) tea.Cmd {
+	var cmds []tea.Cmd
+	mouseMsg, isMouse := msg.(tea.MouseMsg)
+	_, isKey := msg.(tea.KeyMsg)
+
+	switch msg.(type) {
+	case HoverReevaluation:
+		return r.reevaluate()
+	case tea.WindowSizeMsg:
+		cmds = append(cmds, r.Reevaluate())
+	}
+
+	entries := r.entries
+	if isMouse {
+		r.pointer = &mouseMsg
+		entries = r.candidates(mouseMsg)
+	}
+
+	for _, entry := range append([]*registryEntry{}, entries...) {
+		if (isMouse || isKey) && !r.dispatchable(entry) {
+			continue
+		}
+
+		var cmd tea.Cmd
+		element := entry.element
+		if isMouse {
+			element, cmd = element.GetInteraction().HandleMouseMsg(element, mouseMsg)
+		} else {
+			element, cmd = element.GetInteraction().HandleExternalEvent(element, msg)
+		}
+
+		entry.element = element
+		if entry.set != nil {
+			entry.set(element)
+		}
+		r.track(entry)
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	r.captureDrag(msg)
+
+	if r.Cursor != nil {
+		cmds = append(cmds, r.Cursor.Update(r.observers()...))
+	}
+
+	return tea.Batch(cmds...)
+}
+
+//* Drag Capture
+/*
+ - Captures the pointer for an element which started dragging on a left press, and releases a drag capture once the drag is over
+*/
+func (r *Registry) captureDrag(msg tea.Msg) {
+	if r.capture != "" && r.dragged {
+		if entry := r.entry(r.capture); entry == nil || !entry.element.GetInteraction().IsDragging {
+			r.capture, r.dragged = "", false
+		}
+	}
+	if r.capture != "" {
+		return
+	}
+	if mouseMsg, ok := msg.(tea.MouseMsg); !ok || mouseMsg.Action != tea.MouseActionPress || mouseMsg.Button != tea.MouseButtonLeft {
+		return
+	}
+	var dragging *registryEntry
+	for _, entry := range r.active {
+		if entry.element.GetInteraction().IsDragging && r.dispatchable(entry) && (dragging == nil || entry.rank < dragging.rank) {
+			dragging = entry
+		}
+	}
+	if dragging != nil {
+		r.capture, r.dragged = dragging.element.GetInteraction().ID, true
+	}
+}
+
+func (r *Registry) reevaluate() tea.Cmd {
+	if r.pointer == nil {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	for _, entry := range r.nearby(*r.pointer) {
+		if !r.dispatchable(entry) {
+			continue
+		}
+		element, cmd := entry.element.GetInteraction().ReevaluateHover(entry.element, *r.pointer)
+		entry.element = element
+		if entry.set != nil {
+			entry.set(element)
+		}
+		r.track(entry)
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	if r.Cursor != nil {
+		cmds = append(cmds, r.Cursor.Update(r.observers()...))
+	}
+	return tea.Batch(cmds...)
+}
+
+func (r *Registry) entry(id string) *registryEntry {
+	if id == "" {
+		return nil
+	}
+	return r.byID[id]
+}
+
+//* Mouse Candidates
+/*
+ - Returns the capturing element alone while the pointer is captured, otherwise the elements near the pointer
+*/
+func (r *Registry) candidates(mouseMsg tea.MouseMsg) []*registryEntry {
+	if r.capture == "" {
+		return r.nearby(mouseMsg)
+	}
+	if entry := r.entry(r.capture); entry != nil {
+		return []*registryEntry{entry}
+	}
+	return nil
+}
+
+//* Nearby Elements
+/*
+ - Returns every element without a hit index, otherwise those beneath the pointer, those observing the pointer and
+   those which cannot be indexed, in dispatch order
+*/
+func (r *Registry) nearby(mouseMsg tea.MouseMsg) []*registryEntry {
+	if r.index == nil {
+		return r.entries
+	}
+
+	var entries []*registryEntry
+	seen := map[*registryEntry]bool{}
+	add := func(entry *registryEntry) {
+		if entry != nil && !seen[entry] {
+			seen[entry] = true
+			entries = append(entries, entry)
+		}
+	}
+	for _, id := range r.index.At(mouseMsg.X, mouseMsg.Y) {
+		add(r.entry(id))
+	}
+	for _, entry := range r.active {
+		add(entry)
+	}
+	for _, entry := range r.unindexed {
+		add(entry)
+	}
+
+	sort.Slice(entries, func(a, b int) bool {
+		return entries[a].rank < entries[b].rank
+	})
+	return entries
+}
+
+//* Pointer Observers
+/*
+ - Records whether the element is hovered, dragging, beneath a drag or holding back motion
+ - Such elements must see the pointer leave, and are the only ones the cursor shape is resolved from
+*/
+func (r *Registry) track(entry *registryEntry) {
+	i := entry.element.GetInteraction()
+	if i.IsHovered || i.IsDragging || i.IsBelowDrop || i.pendingMotion != nil {
+		r.active[i.ID] = entry
+	} else {
+		delete(r.active, i.ID)
+	}
+}
+
+//* Dispatch Order
+/*
+ - Moves the element to its position by z-index, focus and registration order, keeping the entries sorted
+*/
+func (r *Registry) place(entry *registryEntry) {
+	if entry.rank >= 0 && entry.rank < len(r.entries) && r.entries[entry.rank] == entry {
+		r.entries = append(r.entries[:entry.rank], r.entries[entry.rank+1:]...)
+	}
+	at := sort.Search(len(r.entries), func(i int) bool {
+		return r.precedes(entry, r.entries[i])
+	})
+	r.entries = append(r.entries, nil)
+	copy(r.entries[at+1:], r.entries[at:])
+	r.entries[at] = entry
+	r.rerank()
+}
+
+func (r *Registry) observers() []Interactive {
+	entries := make([]*registryEntry, 0, len(r.active))
+	for _, entry := range r.active {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(a, b int) bool {
+		return entries[a].rank < entries[b].rank
+	})
+
+	elements := make([]Interactive, len(entries))
+	for i, entry := range entries {
+		elements[i] = entry.element
+	}
+	return elements
+}
+
+func (r *Registry) precedes(a, b *registryEntry) bool {
+	if a.z != b.z {
+		return a.z > b.z
+	}
+	focusedA := a.element.GetInteraction().ID == r.focused
+	focusedB := b.element.GetInteraction().ID == r.focused
+	if focusedA != focusedB {
+		return focusedA
+	}
+	return a.seq < b.seq
+}
+
+func (r *Registry) rerank() {
+	for i, entry := range r.entries {
+		entry.rank = i
+	}
+}
@@ -0,0 +1,299 @@
+package teaspoon
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+// Registers count elements one cell wide in rows of columns, then scans them and waits for their bounds
+// The hit index is used when indexed is set, otherwise every element is dispatched to
+func layoutRegistry(tb testing.TB, count, columns int, indexed bool) (*Registry, []*testElement) {
+	tb.Helper()
+	zone.NewGlobal()
+
+	r := NewRegistry()
+	if indexed {
+		r.UseHitIndex(DefaultHitIndexCellSize)
+	}
+	elements := make([]*testElement, count)
+	var view strings.Builder
+	for i := range elements {
+		elements[i] = newTestElement(zone.NewPrefix())
+		r.Register(elements[i], nil)
+		view.WriteString(zone.Mark(elements[i].interaction.ID, "x"))
+		if (i+1)%columns == 0 {
+			view.WriteString("\n")
+		}
+	}
+	zone.Scan(view.String())
+
+	last := elements[count-1].interaction.ID
+	for deadline := time.Now().Add(time.Second); zone.Get(last).IsZero(); time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			tb.Fatal("zones were not stored")
+		}
+	}
+	r.Invalidate()
+	return r, elements
+}
+
+func motion(x, y int) tea.MouseMsg {
+	return tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionMotion, Button: tea.MouseButtonNone}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+func TestRegistryOrder(t *testing.T) {
+	tests := []struct {
+		name  string
+		apply func(r *Registry)
+		want  []string
+	}{
+		{
+			name:  "registration order",
+			apply: func(r *Registry) {},
+			want:  []string{"a", "b", "c"},
+		},
+		{
+			name:  "higher z-index first",
+			apply: func(r *Registry) { r.SetZIndex("c", 2); r.SetZIndex("b", 1) },
+			want:  []string{"c", "b", "a"},
+		},
+		{
+			name:  "focused first among equals",
+			apply: func(r *Registry) { r.Focus("b") },
+			want:  []string{"b", "a", "c"},
+		},
+		{
+			name:  "z-index before focus",
+			apply: func(r *Registry) { r.Focus("b"); r.SetZIndex("c", 1) },
+			want:  []string{"c", "b", "a"},
+		},
+		{
+			name:  "focus moves",
+			apply: func(r *Registry) { r.Focus("c"); r.Focus("b") },
+			want:  []string{"b", "a", "c"},
+		},
+		{
+			name:  "focus cleared",
+			apply: func(r *Registry) { r.Focus("c"); r.Focus("") },
+			want:  []string{"a", "b", "c"},
+		},
+		{
+			name:  "z-index restored",
+			apply: func(r *Registry) { r.SetZIndex("a", -1); r.SetZIndex("a", 0) },
+			want:  []string{"a", "b", "c"},
+		},
+		{
+			name:  "unregistered",
+			apply: func(r *Registry) { r.SetZIndex("c", 1); r.Unregister("b") },
+			want:  []string{"c", "a"},
+		},
+		{
+			name: "re-registered",
+			apply: func(r *Registry) {
+				r.Unregister("a")
+				r.Register(newTestElement("a"), nil)
+			},
+			want: []string{"b", "c", "a"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := NewRegistry()
+			for _, id := range []string{"a", "b", "c"} {
+				r.Register(newTestElement(id), nil)
+			}
+			test.apply(r)
+			if got := elementIDs(r.Elements()); !slices.Equal(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRegistryHitIndexDispatch(t *testing.T) {
+	dispatched := map[string]int{}
+	UseMiddleware(func(next HandlerFunc) HandlerFunc {
+		return func(element Interactive, msg tea.Msg) (Interactive, tea.Cmd) {
+			dispatched[element.GetInteraction().ID]++
+			return next(element, msg)
+		}
+	})
+	defer func() { GlobalMiddleware = nil }()
+
+	r, elements := layoutRegistry(t, 100, 10, true)
+	id := func(x, y int) string {
+		return elements[y*10+x].interaction.ID
+	}
+
+	tests := []struct {
+		name    string
+		msg     tea.MouseMsg
+		want    []string
+		hovered string
+	}{
+		{name: "enter", msg: motion(3, 2), want: []string{id(3, 2)}, hovered: id(3, 2)},
+		{name: "move", msg: motion(4, 2), want: []string{id(3, 2), id(4, 2)}, hovered: id(4, 2)},
+		{name: "leave", msg: motion(40, 40), want: []string{id(4, 2)}},
+		{name: "outside", msg: motion(40, 40), want: []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clear(dispatched)
+			r.Update(test.msg)
+
+			got := []string{}
+			for id := range dispatched {
+				got = append(got, id)
+			}
+			slices.Sort(got)
+			want := slices.Clone(test.want)
+			slices.Sort(want)
+			if !slices.Equal(got, want) {
+				t.Errorf("dispatched to %v, want %v", got, want)
+			}
+			for _, element := range elements {
+				if hovered := element.interaction.ID == test.hovered; element.interaction.IsHovered != hovered {
+					t.Errorf("%s hovered %v, want %v", element.interaction.ID, element.interaction.IsHovered, hovered)
+				}
+			}
+		})
+	}
+}
+
+func TestRegistryDragCapture(t *testing.T) {
+	r, elements := layoutRegistry(t, 20, 10, true)
+	dragged := elements[0]
+	dragged.interaction.Drag = &DragHandler{}
+
+	r.Update(tea.MouseMsg{X: 0, Y: 0, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	r.Update(tea.MouseMsg{X: 5, Y: 1, Action: tea.MouseActionMotion, Button: tea.MouseButtonLeft})
+	if !dragged.interaction.IsDragging || r.PointerCapture() != dragged.interaction.ID {
+		t.Fatalf("dragging %v with capture %q", dragged.interaction.IsDragging, r.PointerCapture())
+	}
+	if elements[15].interaction.IsHovered {
+		t.Error("element beneath a captured pointer was hovered")
+	}
+
+	r.Update(tea.MouseMsg{X: 5, Y: 1, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft})
+	if dragged.interaction.IsDragging || r.PointerCapture() != "" {
+		t.Errorf("dragging %v with capture %q after release", dragged.interaction.IsDragging, r.PointerCapture())
+	}
+}
+
+func TestRegistryWheelPress(t *testing.T) {
+	r, elements := layoutRegistry(t, 20, 10, true)
+	element := elements[0]
+	element.interaction.Drag = &DragHandler{}
+	clicked := 0
+	element.interaction.Click = &ClickHandler{
+		OnClick: func(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
+			clicked++
+			return element, nil
+		},
+	}
+
+	for _, button := range []tea.MouseButton{tea.MouseButtonWheelUp, tea.MouseButtonWheelDown} {
+		r.Update(tea.MouseMsg{X: 0, Y: 0, Action: tea.MouseActionPress, Button: button})
+	}
+	if clicked != 0 || element.interaction.IsDragging || r.PointerCapture() != "" {
+		t.Errorf("wheel clicked %d times, dragging %v with capture %q", clicked, element.interaction.IsDragging, r.PointerCapture())
+	}
+
+	r.Update(tea.MouseMsg{X: 0, Y: 0, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	r.Update(tea.MouseMsg{X: 0, Y: 0, Action: tea.MouseActionPress, Button: tea.MouseButtonWheelDown})
+	if !element.interaction.IsDragging || r.PointerCapture() != element.interaction.ID {
+		t.Errorf("wheel during a drag left dragging %v with capture %q", element.interaction.IsDragging, r.PointerCapture())
+	}
+}
+
+func TestRegistryOcclusion(t *testing.T) {
+	r, elements := layoutRegistry(t, 100, 10, true)
+	overlay := newTestElement("overlay")
+	r.Register(overlay, nil)
+	r.SetZIndex("overlay", 1)
+	r.SetLayers(Layer{View: "...\n...\n.", X: 2, Y: 2, Z: 1})
+
+	tests := []struct {
+		name     string
+		x, y     int
+		occluded bool
+	}{
+		{name: "covered", x: 3, y: 3, occluded: true},
+		{name: "corner", x: 2, y: 4, occluded: true},
+		{name: "short line", x: 4, y: 4, occluded: true},
+		{name: "beside", x: 5, y: 3},
+		{name: "above", x: 3, y: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			element := elements[test.y*10+test.x]
+			r.Update(motion(test.x, test.y))
+			if element.interaction.IsHovered == test.occluded {
+				t.Errorf("hovered %v beneath layer %v", element.interaction.IsHovered, test.occluded)
+			}
+			if r.IsOccluded("overlay", test.x, test.y) {
+				t.Error("layer element occluded by its own layer")
+			}
+		})
+	}
+
+	r.SetLayers()
+	r.Update(motion(3, 3))
+	if !elements[33].interaction.IsHovered {
+		t.Error("element not hovered once the layer was removed")
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+// Measures a pointer moving between two elements, with and without the hit index
+func benchmarkRegistryUpdate(b *testing.B) {
+	for _, indexed := range []bool{true, false} {
+		for _, count := range []int{100, 1000, 10000} {
+			b.Run(fmt.Sprintf("indexed=%v/elements=%d", indexed, count), func(b *testing.B) {
+				r, _ := layoutRegistry(b, count, 100, indexed)
+				msgs := []tea.MouseMsg{motion(10, 0), motion(11, 0)}
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					r.Update(msgs[i%len(msgs)])
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkRegistryUpdate(b *testing.B) {
+	benchmarkRegistryUpdate(b)
+}
+
+func BenchmarkRegistryUpdateWithMiddleware(b *testing.B) {
+	UseMiddleware(func(next HandlerFunc) HandlerFunc { return next })
+	defer func() { GlobalMiddleware = nil }()
+
+	benchmarkRegistryUpdate(b)
+}
+
+func BenchmarkRegistryInvalidate(b *testing.B) {
+	for _, count := range []int{100, 1000, 10000} {
+		b.Run(fmt.Sprintf("elements=%d", count), func(b *testing.B) {
+			r, elements := layoutRegistry(b, count, 100, true)
+			id := elements[10].interaction.ID
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				r.Invalidate(id)
+				r.Update(motion(10, 0))
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package teaspoon
+
+import (
+	"reflect"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Subscription Topics
+/*
+ - Filters selecting which teaspoon events a subscriber receives, where empty fields match everything
+ - ID matches the source element's ID, or every ID beginning with it when it ends in an asterisk
+ - Event matches an event type such as DropAccept, or every type of an event such as DropEvent{}
+*/
+type Topic struct {
+	ID    string
+	Event any
+}
+
+//* Topic Matching
+/*
+ - Returns true if the teaspoon event is selected by the topic
+*/
+func (t Topic) Matches(msg tea.Msg) bool {
+	id, eventType, ok := eventSource(msg)
+	if !ok {
+		return false
+	}
+
+	if prefix, wildcard := strings.CutSuffix(t.ID, "*"); wildcard {
+		if !strings.HasPrefix(id, prefix) {
+			return false
+		}
+	} else if t.ID != "" && t.ID != id {
+		return false
+	}
+
+	switch reflect.TypeOf(t.Event) {
+	case nil, reflect.TypeOf(msg):
+		return true
+	case reflect.TypeOf(eventType):
+		return t.Event == eventType
+	}
+	return false
+}
+
+func eventSource(msg tea.Msg) (string, any, bool) {
+	switch msg := msg.(type) {
+	case ClickEvent:
+		return msg.ID, msg.EventType, true
+	case HoverEvent:
+		return msg.ID, msg.EventType, true
+	case DragEvent:
+		return msg.ID, msg.EventType, true
+	case DropEvent:
+		return msg.ID, msg.EventType, true
+	case ToggleEvent:
+		return msg.ID, nil, true
+	case SelectionChanged:
+		return msg.ID, nil, true
+	}
+	return "", nil, false
+}
+
+//* Scheduled Message Target
+/*
+ - Returns the ID of the element which scheduled the message for itself
+ - Scheduled messages are internal to their element, so they are not events and no topic matches them
+*/
+func scheduledTarget(msg tea.Msg) (string, bool) {
+	switch msg := msg.(type) {
+	case MotionFlush:
+		return msg.ID, true
+	case DragWatchdog:
+		return msg.ID, true
+	}
+	return "", false
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Event Router
+/*
+ - Delivers teaspoon events to their source element and to subscribers, replacing manual ID comparison
+ - Drag events are also delivered to every other registered element with a Drop handler so drop targets work
+ - Elements receive events through HandleExternalEvent and are replaced by the element it returns
+ - Value type elements should be read back with Element after routing, pointer types are updated in place
+*/
+type EventRouter struct {
+	elements      map[string]Interactive
+	order         []string
+	subscriptions []*subscription
+}
+
+type subscription struct {
+	topic   Topic
+	id      string
+	handler func(msg tea.Msg) tea.Cmd
+}
+
+//* Creation Method
+/*
+ - Returns a new router with the given elements registered
+*/
+func NewEventRouter(elements ...Interactive) *EventRouter {
+	r := &EventRouter{elements: map[string]Interactive{}}
+	r.Register(elements...)
+	return r
+}
+
+//* Register Elements
+/*
+ - Registers elements by their Interactable ID, replacing any element previously registered with the same ID
+*/
+func (r *EventRouter) Register(elements ...Interactive) {
+	for _, element := range elements {
+		id := element.GetInteraction().ID
+		if _, exists := r.elements[id]; !exists {
+			r.order = append(r.order, id)
+		}
+		r.elements[id] = element
+	}
+}
+
+//* Unregister Element
+/*
+ - Removes the element with the given ID along with its subscriptions
+*/
+func (r *EventRouter) Unregister(id string) {
+	if _, exists := r.elements[id]; !exists {
+		return
+	}
+	delete(r.elements, id)
+	for i, registered := range r.order {
+		if registered == id {
+			r.order = append(r.order[:i:i], r.order[i+1:]...)
+			break
+		}
+	}
+	subscriptions := r.subscriptions[:0:0]
+	for _, s := range r.subscriptions {
+		if s.id != id {
+			subscriptions = append(subscriptions, s)
+		}
+	}
+	r.subscriptions = subscriptions
+}
+
+//* Registered Element
+/*
+ - Returns the element registered with the given ID, or nil if there is none
+*/
+func (r *EventRouter) Element(id string) Interactive {
+	return r.elements[id]
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Subscribe Element
+/*
+ - Delivers events matching the topic to a registered element through its HandleExternalEvent
+ - The element is registered if it is not already
+*/
+func (r *EventRouter) Subscribe(topic Topic, element Interactive) {
+	r.Register(element)
+	r.subscriptions = append(r.subscriptions, &subscription{topic: topic, id: element.GetInteraction().ID})
+}
+
+//* Subscribe Function
+/*
+ - Calls the handler with events matching the topic and returns a function which cancels the subscription
+*/
+func (r *EventRouter) SubscribeFunc(topic Topic, handler func(msg tea.Msg) tea.Cmd) func() {
+	s := &subscription{topic: topic, handler: handler}
+	r.subscriptions = append(r.subscriptions, s)
+	return func() {
+		for i, subscribed := range r.subscriptions {
+			if subscribed == s {
+				r.subscriptions = append(r.subscriptions[:i:i], r.subscriptions[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Event Routing
+/*
+ - Delivers a teaspoon event to its source, to drop targets when it is a drag event, then to matching subscribers
+ - Each element receives an event at most once, messages which are not teaspoon events are ignored
+ - Messages an element scheduled for itself, such as a DragWatchdog, are only delivered to that element
+*/
+func (r *EventRouter) Route(msg tea.Msg) tea.Cmd {
+	if id, ok := scheduledTarget(msg); ok {
+		return r.deliver(id, msg)
+	}
+
+	id, _, ok := eventSource(msg)
+	if !ok {
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	delivered := map[string]bool{}
+
+	if _, exists := r.elements[id]; exists {
+		cmds = append(cmds, r.deliver(id, msg))
+		delivered[id] = true
+	}
+
+	if _, isDrag := msg.(DragEvent); isDrag {
+		for _, target := range r.order {
+			if !delivered[target] && r.elements[target].GetInteraction().Drop != nil {
+				cmds = append(cmds, r.deliver(target, msg))
+				delivered[target] = true
+			}
+		}
+	}
+
+	for _, s := range append([]*subscription{}, r.subscriptions...) {
+		if !s.topic.Matches(msg) {
+			continue
+		}
+		if s.handler != nil {
+			cmds = append(cmds, s.handler(msg))
+			continue
+		}
+		if !delivered[s.id] {
+			cmds = append(cmds, r.deliver(s.id, msg))
+			delivered[s.id] = true
+		}
+	}
+
+	return tea.Batch(cmds...)
+}
+
+func (r *EventRouter) deliver(id string, msg tea.Msg) tea.Cmd {
+	element, exists := r.elements[id]
+	if !exists {
+		return nil
+	}
+	element, cmd := element.GetInteraction().HandleExternalEvent(element, msg)
+	r.elements[id] = element
+	return cmd
+}
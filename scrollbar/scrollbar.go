@@ -0,0 +1,352 @@
+package scrollbar
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jordanella/teaspoon"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Scrollbar Orientation
+/*
+ - Horizontal scrollbars scroll columns, vertical scrollbars scroll lines
+*/
+type Orientation int
+
+const (
+	Horizontal Orientation = iota
+	Vertical
+)
+
+//* Sync Message
+/*
+ - Updates the scrollbar with the content length, viewport length and offset of the content it is attached to
+ - For a bubbles viewport these are TotalLineCount(), Height and YOffset
+*/
+type SyncMsg struct {
+	ID             string
+	ContentLength  int
+	ViewportLength int
+	Offset         int
+}
+
+//* Scroll Event
+/*
+ - Emitted when the scrollbar changes the offset by dragging, paging or the wheel
+ - The attached content should apply Offset, for a bubbles viewport with SetYOffset
+*/
+type ScrollEvent struct {
+	ID     string
+	Offset int
+}
+
+//* Repeat Message
+/*
+ - Scheduled while the track is held to repeat paging towards the pointer
+*/
+type repeatMsg struct {
+	ID       string
+	sequence int
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Scrollbar Styles
+/*
+ - Styles for the track and the thumb in its normal, hovered and dragged states
+*/
+type Styles struct {
+	Track         lipgloss.Style
+	Thumb         lipgloss.Style
+	ThumbHover    lipgloss.Style
+	ThumbDragging lipgloss.Style
+}
+
+//* Default Scrollbar Styles
+/*
+ - Returns a faint track with a thumb which brightens when hovered or dragged
+*/
+func DefaultStyles() Styles {
+	return Styles{
+		Track:         lipgloss.NewStyle().Faint(true),
+		Thumb:         lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")),
+		ThumbHover:    lipgloss.NewStyle().Foreground(lipgloss.Color("#44aaaa")),
+		ThumbDragging: lipgloss.NewStyle().Foreground(lipgloss.Color("#ffffff")),
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Scrollbar Model
+/*
+ - Standalone scrollbar kept in sync with external content through SyncMsg or Sync
+ - Dragging the thumb scrolls proportionally, pressing the track pages towards the pointer
+ - Holding the track repeats paging after RepeatDelay every RepeatInterval until the thumb reaches the pointer
+ - The wheel scrolls by WheelStep while the pointer is over the scrollbar
+*/
+type Model struct {
+	Orientation    Orientation
+	Length         int
+	ContentLength  int
+	ViewportLength int
+	Offset         int
+	WheelStep      int
+	RepeatDelay    time.Duration
+	RepeatInterval time.Duration
+	Styles         Styles
+
+	track       *teaspoon.Pressable
+	thumb       *teaspoon.Pressable
+	dragOffset  int
+	holding     bool
+	holdMsg     tea.MouseMsg
+	holdCounter int
+}
+
+//* Creation Method
+/*
+ - Returns a new scrollbar of the given orientation
+*/
+func New(orientation Orientation) Model {
+	return Model{
+		Orientation:    orientation,
+		Length:         10,
+		WheelStep:      3,
+		RepeatDelay:    300 * time.Millisecond,
+		RepeatInterval: 50 * time.Millisecond,
+		Styles:         DefaultStyles(),
+		track:          teaspoon.NewPressable(false),
+		thumb:          teaspoon.NewPressable(true),
+	}
+}
+
+//* Scrollbar ID
+/*
+ - Returns the ID of the scrollbar's track, matched by SyncMsg and attached to ScrollEvents
+*/
+func (m Model) ID() string {
+	return m.track.GetInteraction().ID
+}
+
+//* Sync
+/*
+ - Updates the content length, viewport length and offset without emitting a ScrollEvent
+*/
+func (m Model) Sync(contentLength, viewportLength, offset int) Model {
+	m.ContentLength = contentLength
+	m.ViewportLength = viewportLength
+	m.Offset = max(0, min(offset, m.maxOffset()))
+	return m
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Update Routine
+/*
+ - Sync messages addressed to the scrollbar update its content metrics
+ - Mouse messages are passed to the track and thumb, then resolved into offset changes
+ - Other messages are passed to the thumb so drags can be cancelled
+*/
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case SyncMsg:
+		if msg.ID == m.ID() {
+			m = m.Sync(msg.ContentLength, msg.ViewportLength, msg.Offset)
+		}
+		return m, nil
+
+	case repeatMsg:
+		if msg.ID != m.ID() || msg.sequence != m.holdCounter || !m.holding {
+			return m, nil
+		}
+		direction := m.pageDirection(m.holdMsg)
+		if direction == 0 {
+			return m, nil
+		}
+		m, cmd = m.scrollTo(m.Offset + direction*max(m.ViewportLength, 1))
+		return m, tea.Batch(cmd, m.repeat(m.RepeatInterval))
+
+	case tea.MouseMsg:
+		if tea.MouseEvent(msg).IsWheel() {
+			if msg.Action != tea.MouseActionPress || !m.track.GetInteraction().HandleIsInside(m.track, msg) {
+				return m, nil
+			}
+			switch msg.Button {
+			case tea.MouseButtonWheelUp, tea.MouseButtonWheelLeft:
+				return m.scrollTo(m.Offset - m.WheelStep)
+			case tea.MouseButtonWheelDown, tea.MouseButtonWheelRight:
+				return m.scrollTo(m.Offset + m.WheelStep)
+			}
+			return m, nil
+		}
+
+		wasDragging := m.thumb.GetInteraction().IsDragging
+		for _, p := range []*teaspoon.Pressable{m.thumb, m.track} {
+			if _, cmd = p.GetInteraction().HandleMouseMsg(p, msg); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+
+		switch msg.Action {
+		case tea.MouseActionRelease:
+			m.holding = false
+		case tea.MouseActionMotion:
+			if m.holding {
+				m.holdMsg = msg
+			}
+		}
+
+		if _, pressed := m.thumb.TakePress(); pressed {
+			m.track.TakePress()
+		}
+		if pressMsg, pressed := m.track.TakePress(); pressed {
+			if direction := m.pageDirection(pressMsg); direction != 0 {
+				m.holding = true
+				m.holdMsg = pressMsg
+				m.holdCounter++
+				m, cmd = m.scrollTo(m.Offset + direction*max(m.ViewportLength, 1))
+				cmds = append(cmds, cmd, m.repeat(m.RepeatDelay))
+			}
+		}
+
+		thumb := m.thumb.GetInteraction()
+		if thumb.IsDragging && !wasDragging {
+			m.dragOffset = m.Offset
+		} else if thumb.IsDragging {
+			delta := thumb.DragOffset.Y
+			if m.Orientation == Horizontal {
+				delta = thumb.DragOffset.X
+			}
+			_, size := m.thumbGeometry()
+			if travel := max(m.Length, 1) - size; travel > 0 {
+				offset := m.dragOffset + int(math.Round(float64(delta)*float64(m.maxOffset())/float64(travel)))
+				m, cmd = m.scrollTo(offset)
+				cmds = append(cmds, cmd)
+			}
+		}
+
+	default:
+		if _, cmd = m.thumb.GetInteraction().HandleExternalEvent(m.thumb, msg); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+//* Scroll To Offset
+/*
+ - Sets the offset within range and returns a command emitting a ScrollEvent if it changed
+*/
+func (m Model) scrollTo(offset int) (Model, tea.Cmd) {
+	offset = max(0, min(offset, m.maxOffset()))
+	if offset == m.Offset {
+		return m, nil
+	}
+	m.Offset = offset
+
+	scrollEvent := ScrollEvent{ID: m.ID(), Offset: offset}
+	return m, func() tea.Msg {
+		return scrollEvent
+	}
+}
+
+func (m Model) repeat(delay time.Duration) tea.Cmd {
+	repeat := repeatMsg{ID: m.ID(), sequence: m.holdCounter}
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return repeat
+	})
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Scrollbar View
+/*
+ - Renders the track and thumb, marking the track and thumb with their IDs
+*/
+func (m Model) View() string {
+	length := max(m.Length, 1)
+	position, size := m.thumbGeometry()
+
+	thumb := m.thumb.GetInteraction()
+	style := m.Styles.Thumb
+	if thumb.IsDragging {
+		style = m.Styles.ThumbDragging
+	} else if thumb.IsHovered {
+		style = m.Styles.ThumbHover
+	}
+
+	separator := "\n"
+	if m.Orientation == Horizontal {
+		separator = ""
+	}
+
+	before := m.Styles.Track.Render(strings.Repeat("░", position))
+	bar := zone.Mark(thumb.ID, style.Render(strings.Repeat("█", size)))
+	after := m.Styles.Track.Render(strings.Repeat("░", length-position-size))
+	if m.Orientation == Vertical {
+		before = m.Styles.Track.Render(strings.TrimSuffix(strings.Repeat("░\n", position), "\n"))
+		bar = zone.Mark(thumb.ID, style.Render(strings.TrimSuffix(strings.Repeat("█\n", size), "\n")))
+		after = m.Styles.Track.Render(strings.TrimSuffix(strings.Repeat("░\n", length-position-size), "\n"))
+	}
+
+	var parts []string
+	for _, part := range []string{before, bar, after} {
+		if lipgloss.Width(part) > 0 {
+			parts = append(parts, part)
+		}
+	}
+	return zone.Mark(m.ID(), strings.Join(parts, separator))
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Geometry Helpers
+/*
+ - Compute the thumb position and size on the track and the direction of the pointer from the thumb
+*/
+func (m Model) thumbGeometry() (position, size int) {
+	length := max(m.Length, 1)
+	if m.ContentLength <= m.ViewportLength || m.ContentLength <= 0 {
+		return 0, length
+	}
+
+	size = int(math.Round(float64(length) * float64(m.ViewportLength) / float64(m.ContentLength)))
+	size = max(1, min(size, length))
+	position = int(math.Round(float64(length-size) * float64(m.Offset) / float64(m.maxOffset())))
+	return max(0, min(position, length-size)), size
+}
+
+func (m Model) pageDirection(mouseMsg tea.MouseMsg) int {
+	bounds := zone.Get(m.ID())
+	if bounds.IsZero() {
+		return 0
+	}
+
+	pointer := mouseMsg.Y - bounds.StartY
+	if m.Orientation == Horizontal {
+		pointer = mouseMsg.X - bounds.StartX
+	}
+
+	position, size := m.thumbGeometry()
+	switch {
+	case pointer < position:
+		return -1
+	case pointer >= position+size:
+		return 1
+	}
+	return 0
+}
+
+func (m Model) maxOffset() int {
+	return max(m.ContentLength-m.ViewportLength, 0)
+}
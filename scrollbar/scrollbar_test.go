@@ -0,0 +1,51 @@
+package scrollbar
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+// Scans the view and waits for the zone with the given ID to be stored again
+func scan(t *testing.T, view, id string) {
+	t.Helper()
+	zone.Clear(id)
+	zone.Scan(view)
+	for deadline := time.Now().Add(time.Second); zone.Get(id).IsZero(); time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("zones were not stored")
+		}
+	}
+}
+
+func TestScrollbarThumbDrag(t *testing.T) {
+	zone.NewGlobal()
+	m := New(Vertical).Sync(100, 10, 0)
+	scan(t, m.View(), m.thumb.GetInteraction().ID)
+
+	tests := []struct {
+		name   string
+		msg    tea.MouseMsg
+		offset int
+	}{
+		{name: "press on the thumb", msg: tea.MouseMsg{X: 0, Y: 0, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft}, offset: 0},
+		{name: "drag", msg: tea.MouseMsg{X: 0, Y: 3, Action: tea.MouseActionMotion, Button: tea.MouseButtonLeft}, offset: 30},
+		{name: "drag past the end", msg: tea.MouseMsg{X: 0, Y: 20, Action: tea.MouseActionMotion, Button: tea.MouseButtonLeft}, offset: 90},
+		{name: "drag back", msg: tea.MouseMsg{X: 0, Y: 1, Action: tea.MouseActionMotion, Button: tea.MouseButtonLeft}, offset: 10},
+		{name: "release", msg: tea.MouseMsg{X: 0, Y: 1, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft}, offset: 10},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m, _ = m.Update(test.msg)
+			if m.Offset != test.offset {
+				t.Errorf("offset %d, want %d", m.Offset, test.offset)
+			}
+		})
+	}
+	if m.thumb.GetInteraction().IsDragging || m.holding {
+		t.Error("thumb still dragging or track still held after release")
+	}
+}
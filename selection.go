@@ -0,0 +1,201 @@
+package teaspoon
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Selection Modes
+/*
+ - Enum defining whether a selection group allows one or many selected members
+*/
+type SelectionMode int
+
+const (
+	SingleSelection SelectionMode = iota
+	MultipleSelection
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Selection Group
+/*
+ - Coordinates the IsSelected property of its members so selection can be made exclusive or extended
+ - Members are kept in order, which is used to extend a selection across a range
+*/
+type SelectionGroup struct {
+	Mode SelectionMode
+
+	members []Interactive
+	anchor  string
+}
+
+//* Creation Method
+/*
+ - Returns a new selection group with the given mode and members
+*/
+func NewSelectionGroup(mode SelectionMode, members ...Interactive) *SelectionGroup {
+	return &SelectionGroup{
+		Mode:    mode,
+		members: members,
+	}
+}
+
+//* Set Members
+/*
+ - Replaces the group's members, preserving their selection state, in the order used for range selection
+*/
+func (g *SelectionGroup) SetMembers(members ...Interactive) {
+	g.members = members
+}
+
+//* Add Members
+/*
+ - Appends members to the end of the group
+*/
+func (g *SelectionGroup) Add(members ...Interactive) {
+	g.members = append(g.members, members...)
+}
+
+//* Remove Member
+/*
+ - Removes the member with the given ID, clearing its selection
+*/
+func (g *SelectionGroup) Remove(id string) {
+	for i, member := range g.members {
+		if interaction := member.GetInteraction(); interaction.ID == id {
+			interaction.IsSelected = false
+			g.members = append(g.members[:i:i], g.members[i+1:]...)
+			return
+		}
+	}
+}
+
+//* Members
+/*
+ - Returns the group's members in order
+*/
+func (g *SelectionGroup) Members() []Interactive {
+	return g.members
+}
+
+//* Selected Members
+/*
+ - Returns the selected members in order
+*/
+func (g *SelectionGroup) Selected() []Interactive {
+	var selected []Interactive
+	for _, member := range g.members {
+		if member.GetInteraction().IsSelected {
+			selected = append(selected, member)
+		}
+	}
+	return selected
+}
+
+//* Selected IDs
+/*
+ - Returns the IDs of the selected members in order
+*/
+func (g *SelectionGroup) SelectedIDs() []string {
+	var ids []string
+	for _, member := range g.Selected() {
+		ids = append(ids, member.GetInteraction().ID)
+	}
+	return ids
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Select Member
+/*
+ - Selects the member with the given ID and deselects every other member
+*/
+func (g *SelectionGroup) Select(id string) {
+	for _, member := range g.members {
+		interaction := member.GetInteraction()
+		interaction.IsSelected = interaction.ID == id
+	}
+	g.anchor = id
+}
+
+//* Toggle Member
+/*
+ - Flips the selection of the member with the given ID, leaving other members selected in MultipleSelection mode
+ - In SingleSelection mode a toggled member becomes the only selection
+*/
+func (g *SelectionGroup) Toggle(id string) {
+	if g.Mode == SingleSelection {
+		for _, member := range g.members {
+			interaction := member.GetInteraction()
+			interaction.IsSelected = interaction.ID == id && !interaction.IsSelected
+		}
+	} else {
+		for _, member := range g.members {
+			if interaction := member.GetInteraction(); interaction.ID == id {
+				interaction.IsSelected = !interaction.IsSelected
+			}
+		}
+	}
+	g.anchor = id
+}
+
+//* Extend Selection
+/*
+ - Selects every member between the last selected or toggled member and the given ID
+ - Behaves as Select in SingleSelection mode or when there is no anchor
+*/
+func (g *SelectionGroup) Extend(id string) {
+	from, to := g.indexOf(g.anchor), g.indexOf(id)
+	if g.Mode == SingleSelection || from < 0 || to < 0 {
+		g.Select(id)
+		return
+	}
+	if from > to {
+		from, to = to, from
+	}
+	for i, member := range g.members {
+		member.GetInteraction().IsSelected = i >= from && i <= to
+	}
+}
+
+//* Clear Selection
+/*
+ - Deselects every member
+*/
+func (g *SelectionGroup) Clear() {
+	for _, member := range g.members {
+		member.GetInteraction().IsSelected = false
+	}
+	g.anchor = ""
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Selection Click Behaviour
+/*
+ - Click behaviour for group members, suitable as a ClickHandler's OnClick
+ - Ctrl toggles the member, Shift extends the selection to it, and a plain click selects it alone
+*/
+func (g *SelectionGroup) HandleClick(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
+	id := element.GetInteraction().ID
+	switch {
+	case mouseMsg.Ctrl:
+		g.Toggle(id)
+	case mouseMsg.Shift:
+		g.Extend(id)
+	default:
+		g.Select(id)
+	}
+	return element, nil
+}
+
+func (g *SelectionGroup) indexOf(id string) int {
+	for i, member := range g.members {
+		if member.GetInteraction().ID == id {
+			return i
+		}
+	}
+	return -1
+}
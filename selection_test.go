@@ -0,0 +1,81 @@
+package teaspoon
+
+import (
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSelectionGroup(t *testing.T) {
+	type click struct {
+		id          string
+		ctrl, shift bool
+	}
+
+	tests := []struct {
+		name   string
+		mode   SelectionMode
+		clicks []click
+		want   []string
+	}{
+		{name: "single select", mode: SingleSelection, clicks: []click{{id: "b"}}, want: []string{"b"}},
+		{name: "single reselect", mode: SingleSelection, clicks: []click{{id: "b"}, {id: "d"}}, want: []string{"d"}},
+		{name: "single toggle on", mode: SingleSelection, clicks: []click{{id: "b"}, {id: "d", ctrl: true}}, want: []string{"d"}},
+		{name: "single toggle off", mode: SingleSelection, clicks: []click{{id: "b"}, {id: "b", ctrl: true}}, want: []string{}},
+		{name: "single extend", mode: SingleSelection, clicks: []click{{id: "b"}, {id: "d", shift: true}}, want: []string{"d"}},
+		{name: "multiple select", mode: MultipleSelection, clicks: []click{{id: "b"}, {id: "d"}}, want: []string{"d"}},
+		{name: "multiple toggle", mode: MultipleSelection, clicks: []click{{id: "b"}, {id: "d", ctrl: true}}, want: []string{"b", "d"}},
+		{name: "multiple toggle off", mode: MultipleSelection, clicks: []click{{id: "b"}, {id: "d", ctrl: true}, {id: "b", ctrl: true}}, want: []string{"d"}},
+		{name: "extend forward", mode: MultipleSelection, clicks: []click{{id: "b"}, {id: "d", shift: true}}, want: []string{"b", "c", "d"}},
+		{name: "extend backward", mode: MultipleSelection, clicks: []click{{id: "d"}, {id: "a", shift: true}}, want: []string{"a", "b", "c", "d"}},
+		{name: "extend keeps anchor", mode: MultipleSelection, clicks: []click{{id: "c"}, {id: "e", shift: true}, {id: "a", shift: true}}, want: []string{"a", "b", "c"}},
+		{name: "extend from toggle", mode: MultipleSelection, clicks: []click{{id: "a"}, {id: "c", ctrl: true}, {id: "e", shift: true}}, want: []string{"c", "d", "e"}},
+		{name: "extend without anchor", mode: MultipleSelection, clicks: []click{{id: "c", shift: true}}, want: []string{"c"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var members []Interactive
+			for _, id := range []string{"a", "b", "c", "d", "e"} {
+				members = append(members, newTestElement(id))
+			}
+			group := NewSelectionGroup(test.mode, members...)
+
+			for _, click := range test.clicks {
+				element := members[slices.IndexFunc(members, func(member Interactive) bool {
+					return member.GetInteraction().ID == click.id
+				})]
+				group.HandleClick(element, tea.MouseMsg{Ctrl: click.ctrl, Shift: click.shift})
+			}
+
+			if got := group.SelectedIDs(); !slices.Equal(append([]string{}, got...), test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSelectionGroupMembers(t *testing.T) {
+	a, b, c := newTestElement("a"), newTestElement("b"), newTestElement("c")
+	group := NewSelectionGroup(MultipleSelection, a, b)
+	group.Select("a")
+	group.Add(c)
+	group.Extend("c")
+	if got := group.SelectedIDs(); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Fatalf("after adding got %v", got)
+	}
+
+	group.Remove("b")
+	if got := elementIDs(group.Members()); !slices.Equal(got, []string{"a", "c"}) {
+		t.Errorf("members %v after removal", got)
+	}
+	if got := group.SelectedIDs(); !slices.Equal(got, []string{"a", "c"}) {
+		t.Errorf("selected %v after removal", got)
+	}
+
+	group.Clear()
+	if got := group.SelectedIDs(); len(got) != 0 {
+		t.Errorf("selected %v after clearing", got)
+	}
+}
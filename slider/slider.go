@@ -0,0 +1,379 @@
+package slider
+
+import (
+	"math"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jordanella/teaspoon"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Slider Orientation
+/*
+ - Horizontal sliders increase left to right, vertical sliders increase bottom to top
+*/
+type Orientation int
+
+const (
+	Horizontal Orientation = iota
+	Vertical
+)
+
+//* Value Changed Event
+/*
+ - Emitted whenever a thumb's value changes by clicking, dragging, scrolling or keyboard
+ - Thumb is the index of the changed thumb, Low and High are both equal to Value for single sliders
+*/
+type ValueChangedEvent struct {
+	ID    string
+	Thumb int
+	Value float64
+	Low   float64
+	High  float64
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Slider Styles
+/*
+ - Styles for the track, the filled portion of the track and the thumbs
+*/
+type Styles struct {
+	Track       lipgloss.Style
+	Fill        lipgloss.Style
+	Thumb       lipgloss.Style
+	ThumbHover  lipgloss.Style
+	ThumbActive lipgloss.Style
+}
+
+//* Default Slider Styles
+/*
+ - Returns a faint track with a highlighted fill, brightening the thumb when hovered or active
+*/
+func DefaultStyles() Styles {
+	return Styles{
+		Track:       lipgloss.NewStyle().Faint(true),
+		Fill:        lipgloss.NewStyle().Foreground(lipgloss.Color("#44aaaa")),
+		Thumb:       lipgloss.NewStyle().Foreground(lipgloss.Color("#44aaaa")),
+		ThumbHover:  lipgloss.NewStyle().Foreground(lipgloss.Color("#88dddd")),
+		ThumbActive: lipgloss.NewStyle().Foreground(lipgloss.Color("#ffffff")).Bold(true),
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Slider Model
+/*
+ - Numeric slider with one thumb, or two thumbs selecting a range if Range is true
+ - Clicking the track moves the nearest thumb to the pointer, dragging a thumb scrubs its value
+ - The wheel steps the nearest thumb while the pointer is over the track
+ - While focused, arrow keys step the active thumb, Home and End jump to the limits, and Tab switches thumbs
+*/
+type Model struct {
+	Orientation Orientation
+	Min         float64
+	Max         float64
+	Step        float64
+	Length      int
+	Range       bool
+	Focused     bool
+	Styles      Styles
+
+	values [2]float64
+	active int
+	track  *teaspoon.Pressable
+	thumbs [2]*teaspoon.Pressable
+}
+
+//* Creation Method
+/*
+ - Returns a new single thumb slider between min and max, moving in increments of step
+ - A step of zero allows any value
+*/
+func New(min, max, step float64) Model {
+	return Model{
+		Min:    min,
+		Max:    max,
+		Step:   step,
+		Length: 20,
+		Styles: DefaultStyles(),
+		values: [2]float64{min, max},
+		track:  teaspoon.NewPressable(false),
+		thumbs: [2]*teaspoon.Pressable{teaspoon.NewPressable(true), teaspoon.NewPressable(true)},
+	}
+}
+
+//* Range Creation Method
+/*
+ - Returns a new dual thumb slider selecting a range between min and max
+*/
+func NewRange(min, max, step float64) Model {
+	m := New(min, max, step)
+	m.Range = true
+	return m
+}
+
+//* Slider ID
+/*
+ - Returns the ID of the slider's track, attached to its ValueChangedEvents
+*/
+func (m Model) ID() string {
+	return m.track.GetInteraction().ID
+}
+
+//* Value
+/*
+ - Returns the value of a single thumb slider, or the low value of a range slider
+*/
+func (m Model) Value() float64 {
+	return m.values[0]
+}
+
+//* Range Values
+/*
+ - Returns the low and high values of a range slider
+*/
+func (m Model) Values() (float64, float64) {
+	if !m.Range {
+		return m.values[0], m.values[0]
+	}
+	return m.values[0], m.values[1]
+}
+
+//* Set Value
+/*
+ - Sets the value of the given thumb, snapped to Step and kept within the limits and the other thumb
+*/
+func (m Model) SetValue(thumb int, value float64) Model {
+	m.values[thumb] = m.constrain(thumb, value)
+	return m
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Update Routine
+/*
+ - Mouse messages are passed to the thumbs and track, then resolved into value changes
+ - Key messages are handled while focused, other messages are passed to the thumbs to cancel drags
+*/
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.MouseMsg:
+		if tea.MouseEvent(msg).IsWheel() {
+			if msg.Action != tea.MouseActionPress || !m.track.GetInteraction().HandleIsInside(m.track, msg) {
+				return m, nil
+			}
+			thumb := m.nearest(m.valueAt(msg))
+			switch msg.Button {
+			case tea.MouseButtonWheelUp, tea.MouseButtonWheelRight:
+				return m.change(thumb, m.values[thumb]+m.increment())
+			case tea.MouseButtonWheelDown, tea.MouseButtonWheelLeft:
+				return m.change(thumb, m.values[thumb]-m.increment())
+			}
+			return m, nil
+		}
+
+		for _, p := range m.parts() {
+			if _, cmd = p.GetInteraction().HandleMouseMsg(p, msg); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+
+		if pressMsg, pressed := m.track.TakePress(); pressed {
+			value := m.valueAt(pressMsg)
+			m.active = m.nearest(value)
+			m, cmd = m.change(m.active, value)
+			cmds = append(cmds, cmd)
+		}
+		for thumb, p := range m.thumbs {
+			p.TakePress()
+			if p.GetInteraction().IsDragging {
+				m.active = thumb
+				m, cmd = m.change(thumb, m.valueAt(p.GetInteraction().DragPosition()))
+				cmds = append(cmds, cmd)
+			}
+		}
+
+	case tea.KeyMsg:
+		if m.Focused {
+			switch msg.Type {
+			case tea.KeyRight, tea.KeyUp:
+				m, cmd = m.change(m.active, m.values[m.active]+m.increment())
+			case tea.KeyLeft, tea.KeyDown:
+				m, cmd = m.change(m.active, m.values[m.active]-m.increment())
+			case tea.KeyHome:
+				m, cmd = m.change(m.active, m.Min)
+			case tea.KeyEnd:
+				m, cmd = m.change(m.active, m.Max)
+			case tea.KeyTab:
+				if m.Range {
+					m.active = 1 - m.active
+				}
+			}
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	if _, ok := msg.(tea.MouseMsg); !ok {
+		for _, p := range m.thumbs {
+			if _, cmd = p.GetInteraction().HandleExternalEvent(p, msg); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+//* Value Change
+/*
+ - Sets the thumb's value and returns a command emitting a ValueChangedEvent if it changed
+*/
+func (m Model) change(thumb int, value float64) (Model, tea.Cmd) {
+	value = m.constrain(thumb, value)
+	if value == m.values[thumb] {
+		return m, nil
+	}
+	m.values[thumb] = value
+
+	low, high := m.Values()
+	valueChangedEvent := ValueChangedEvent{
+		ID:    m.ID(),
+		Thumb: thumb,
+		Value: value,
+		Low:   low,
+		High:  high,
+	}
+	return m, func() tea.Msg {
+		return valueChangedEvent
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Slider View
+/*
+ - Renders the track with the filled portion and thumbs, marking the track and each thumb
+*/
+func (m Model) View() string {
+	length := max(m.Length, 2)
+
+	fill, track, thumbChar := "━", "─", "●"
+	if m.Orientation == Vertical {
+		fill, track = "┃", "│"
+	}
+
+	positions := []int{m.position(m.values[0], length)}
+	if m.Range {
+		positions = append(positions, m.position(m.values[1], length))
+	}
+	fillStart, fillEnd := 0, positions[0]
+	if m.Range {
+		fillStart, fillEnd = positions[0], positions[1]
+	}
+
+	cells := make([]string, length)
+	for i := range cells {
+		if i >= fillStart && i <= fillEnd {
+			cells[i] = m.Styles.Fill.Render(fill)
+		} else {
+			cells[i] = m.Styles.Track.Render(track)
+		}
+	}
+	for thumb, position := range positions {
+		interaction := m.thumbs[thumb].GetInteraction()
+		style := m.Styles.Thumb
+		if interaction.IsDragging || (m.Focused && thumb == m.active) {
+			style = m.Styles.ThumbActive
+		} else if interaction.IsHovered {
+			style = m.Styles.ThumbHover
+		}
+		cells[position] = zone.Mark(interaction.ID, style.Render(thumbChar))
+	}
+
+	if m.Orientation == Vertical {
+		for i, j := 0, len(cells)-1; i < j; i, j = i+1, j-1 {
+			cells[i], cells[j] = cells[j], cells[i]
+		}
+		return zone.Mark(m.ID(), strings.Join(cells, "\n"))
+	}
+	return zone.Mark(m.ID(), strings.Join(cells, ""))
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Value Helpers
+/*
+ - Convert between values and track cells, keeping values snapped, within limits and ordered
+*/
+func (m Model) valueAt(mouseMsg tea.MouseMsg) float64 {
+	bounds := zone.Get(m.ID())
+	if bounds.IsZero() {
+		return m.values[m.active]
+	}
+
+	offset, length := mouseMsg.X-bounds.StartX, bounds.EndX-bounds.StartX
+	if m.Orientation == Vertical {
+		offset, length = bounds.EndY-mouseMsg.Y, bounds.EndY-bounds.StartY
+	}
+	if length <= 0 {
+		return m.Min
+	}
+
+	fraction := math.Max(0, math.Min(1, float64(offset)/float64(length)))
+	return m.Min + fraction*(m.Max-m.Min)
+}
+
+func (m Model) position(value float64, length int) int {
+	if m.Max == m.Min {
+		return 0
+	}
+	fraction := (value - m.Min) / (m.Max - m.Min)
+	return int(math.Round(fraction * float64(length-1)))
+}
+
+func (m Model) constrain(thumb int, value float64) float64 {
+	if m.Step > 0 {
+		value = m.Min + math.Round((value-m.Min)/m.Step)*m.Step
+	}
+	low, high := m.Min, m.Max
+	if m.Range {
+		if thumb == 0 {
+			high = m.values[1]
+		} else {
+			low = m.values[0]
+		}
+	}
+	return math.Max(low, math.Min(high, value))
+}
+
+func (m Model) increment() float64 {
+	if m.Step > 0 {
+		return m.Step
+	}
+	return (m.Max - m.Min) / float64(max(m.Length-1, 1))
+}
+
+func (m Model) nearest(value float64) int {
+	if m.Range && math.Abs(value-m.values[1]) < math.Abs(value-m.values[0]) {
+		return 1
+	}
+	if m.Range && m.values[0] == m.values[1] && value > m.values[1] {
+		return 1
+	}
+	return 0
+}
+
+func (m Model) parts() []*teaspoon.Pressable {
+	if m.Range {
+		return []*teaspoon.Pressable{m.thumbs[0], m.thumbs[1], m.track}
+	}
+	return []*teaspoon.Pressable{m.thumbs[0], m.track}
+}
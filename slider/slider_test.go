@@ -0,0 +1,57 @@
+package slider
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+// Scans the view and waits for the zone with the given ID to be stored again
+func scan(t *testing.T, view, id string) {
+	t.Helper()
+	zone.Clear(id)
+	zone.Scan(view)
+	for deadline := time.Now().Add(time.Second); zone.Get(id).IsZero(); time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("zones were not stored")
+		}
+	}
+}
+
+func TestSliderThumbDrag(t *testing.T) {
+	zone.NewGlobal()
+
+	tests := []struct {
+		name      string
+		slider    Model
+		thumb     int
+		from, to  int
+		low, high float64
+	}{
+		{name: "single", slider: New(0, 10, 1), from: 0, to: 7, low: 7, high: 7},
+		{name: "past the end", slider: New(0, 10, 1), from: 0, to: 15, low: 10, high: 10},
+		{name: "range high thumb", slider: NewRange(0, 10, 1).SetValue(0, 3), thumb: 1, from: 10, to: 6, low: 3, high: 6},
+		{name: "range thumbs kept ordered", slider: NewRange(0, 10, 1).SetValue(0, 3), thumb: 1, from: 10, to: 1, low: 3, high: 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := test.slider
+			m.Length = 11
+			scan(t, m.View(), m.thumbs[test.thumb].GetInteraction().ID)
+
+			m, _ = m.Update(tea.MouseMsg{X: test.from, Y: 0, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+			m, _ = m.Update(tea.MouseMsg{X: test.to, Y: 0, Action: tea.MouseActionMotion, Button: tea.MouseButtonLeft})
+			m, _ = m.Update(tea.MouseMsg{X: test.to, Y: 0, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft})
+
+			if low, high := m.Values(); low != test.low || high != test.high {
+				t.Errorf("values %v to %v, want %v to %v", low, high, test.low, test.high)
+			}
+			if m.thumbs[test.thumb].GetInteraction().IsDragging {
+				t.Error("thumb still dragging after release")
+			}
+		})
+	}
+}
@@ -0,0 +1,522 @@
+package split
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jordanella/teaspoon"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Split Orientation
+/*
+ - Horizontal splits place panes side by side with vertical dividers
+ - Vertical splits stack panes with horizontal dividers
+*/
+type Orientation int
+
+const (
+	Horizontal Orientation = iota
+	Vertical
+)
+
+//* Pane Content
+/*
+ - Interface definition for anything rendered within a pane at the size allotted to it
+ - Split models implement Content so they can be nested within panes
+*/
+type Content interface {
+	Render(width, height int) string
+}
+
+//* Content Function
+/*
+ - Adapter allowing a plain function to be used as pane Content
+*/
+type ContentFunc func(width, height int) string
+
+func (f ContentFunc) Render(width, height int) string {
+	return f(width, height)
+}
+
+//* Pane
+/*
+ - A region of the split with optional Min and Max sizes along the split axis
+ - A Max of zero leaves the pane unbounded
+*/
+type Pane struct {
+	Content Content
+	Min     int
+	Max     int
+}
+
+//* Resize Event
+/*
+ - Emitted when a divider is dragged or reset, carrying the new pane sizes and ratios
+*/
+type ResizeEvent struct {
+	ID     string
+	Sizes  []int
+	Ratios []float64
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Split Styles
+/*
+ - Divider styles reflecting its hover and drag state
+*/
+type Styles struct {
+	Divider         lipgloss.Style
+	DividerHover    lipgloss.Style
+	DividerDragging lipgloss.Style
+}
+
+//* Default Split Styles
+/*
+ - Returns a faint divider which is highlighted when hovered or dragged
+*/
+func DefaultStyles() Styles {
+	return Styles{
+		Divider:         lipgloss.NewStyle().Faint(true),
+		DividerHover:    lipgloss.NewStyle().Foreground(lipgloss.Color("#44aaaa")),
+		DividerDragging: lipgloss.NewStyle().Foreground(lipgloss.Color("#ffffff")).Bold(true),
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Divider
+/*
+ - Interactive handle between two panes, resized by dragging and reset by double clicking
+*/
+type divider struct {
+	reset       bool
+	interaction *teaspoon.Interactable
+}
+
+func (d *divider) GetInteraction() *teaspoon.Interactable {
+	return d.interaction
+}
+
+func newDivider(id string, orientation Orientation) *divider {
+	cursor := teaspoon.EWResizeCursor
+	if orientation == Vertical {
+		cursor = teaspoon.NSResizeCursor
+	}
+	return &divider{
+		interaction: &teaspoon.Interactable{
+			ID:     id,
+			Cursor: cursor,
+			Click: &teaspoon.ClickHandler{
+				OnClick: func(element teaspoon.Interactive, mouseMsg tea.MouseMsg) (teaspoon.Interactive, tea.Cmd) {
+					return element, nil
+				},
+				OnDoubleClick: func(element teaspoon.Interactive, mouseMsg tea.MouseMsg) (teaspoon.Interactive, tea.Cmd) {
+					element.(*divider).reset = true
+					return element, nil
+				},
+			},
+			Hover: &teaspoon.HoverHandler{},
+			Drag:  &teaspoon.DragHandler{},
+		},
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Split Model
+/*
+ - Divides its area between panes separated by draggable dividers
+ - Pane sizes are held as ratios so they persist across terminal resizes
+ - Double clicking a divider restores the initial ratios
+ - Panes may be added or removed at any time, existing panes keep their ratios and new panes an average share
+*/
+type Model struct {
+	Orientation Orientation
+	Panes       []Pane
+	Width       int
+	Height      int
+	Styles      Styles
+
+	id         string
+	ratios     []float64
+	defaults   []float64
+	dividers   []*divider
+	dragStart  []int
+	dragActive int
+}
+
+//* Creation Method
+/*
+ - Returns a new split dividing its area evenly between the panes
+*/
+func New(orientation Orientation, panes ...Pane) Model {
+	ratios := make([]float64, len(panes))
+	for i := range ratios {
+		ratios[i] = 1 / float64(len(panes))
+	}
+	return NewWithRatios(orientation, ratios, panes...)
+}
+
+//* Creation Method With Ratios
+/*
+ - Returns a new split dividing its area by the provided ratios, which are restored on double click
+*/
+func NewWithRatios(orientation Orientation, ratios []float64, panes ...Pane) Model {
+	m := Model{
+		Orientation: orientation,
+		Panes:       panes,
+		Styles:      DefaultStyles(),
+		id:          zone.NewPrefix(),
+		ratios:      normalize(ratios, len(panes)),
+		defaults:    normalize(ratios, len(panes)),
+		dragActive:  -1,
+	}
+	m.sync()
+	return m
+}
+
+//* Split ID
+/*
+ - Returns the ID attached to the split's ResizeEvents
+*/
+func (m Model) ID() string {
+	return m.id
+}
+
+//* Ratios
+/*
+ - Returns a copy of the current pane ratios
+*/
+func (m Model) Ratios() []float64 {
+	m.sync()
+	return append([]float64(nil), m.ratios...)
+}
+
+//* Sizes
+/*
+ - Returns the current pane sizes along the split axis
+*/
+func (m Model) Sizes() []int {
+	m.sync()
+	return m.layout(m.available())
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Update Routine
+/*
+ - Window size messages resize the split, preserving pane ratios
+ - Mouse messages are passed to the dividers and nested splits
+ - Dragged dividers move the boundary between their neighbouring panes within Min and Max
+*/
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+
+	m.sync()
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.Width = msg.Width
+		m.Height = msg.Height
+		cmds = append(cmds, m.resizeEvent())
+
+	case tea.MouseMsg:
+		for i, d := range m.dividers {
+			wasDragging := d.interaction.IsDragging
+			if _, cmd = d.interaction.HandleMouseMsg(d, msg); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+
+			switch {
+			case d.reset:
+				d.reset = false
+				m.ratios = append([]float64(nil), m.defaults...)
+				cmds = append(cmds, m.resizeEvent())
+			case d.interaction.IsDragging && !wasDragging:
+				m.dragActive = i
+				m.dragStart = m.Sizes()
+			case d.interaction.IsDragging && m.dragActive == i:
+				if m.resize(i, d.interaction.DragOffset) {
+					cmds = append(cmds, m.resizeEvent())
+				}
+			case !d.interaction.IsDragging && m.dragActive == i:
+				m.dragActive = -1
+			}
+		}
+
+	default:
+		for _, d := range m.dividers {
+			if _, cmd = d.interaction.HandleExternalEvent(d, msg); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+	}
+
+	m.propagate()
+	_, isWindowSize := msg.(tea.WindowSizeMsg)
+	for i, pane := range m.Panes {
+		if nested, ok := pane.Content.(Model); ok && !isWindowSize {
+			nested, cmd = nested.Update(msg)
+			m.Panes[i].Content = nested
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+//* Divider Resize
+/*
+ - Moves the boundary after pane index by the drag offset from the sizes at drag start
+ - Returns true if the pane ratios changed
+*/
+func (m *Model) resize(index int, offset teaspoon.Point) bool {
+	delta := offset.X
+	if m.Orientation == Vertical {
+		delta = offset.Y
+	}
+
+	a, b := m.Panes[index], m.Panes[index+1]
+	startA, startB := m.dragStart[index], m.dragStart[index+1]
+
+	low := max(a.Min-startA, -startA)
+	if b.Max > 0 {
+		low = max(low, startB-b.Max)
+	}
+	high := min(startB-b.Min, startB)
+	if a.Max > 0 {
+		high = min(high, a.Max-startA)
+	}
+	delta = max(low, min(delta, high))
+
+	sizes := append([]int(nil), m.dragStart...)
+	sizes[index] += delta
+	sizes[index+1] -= delta
+
+	available := m.available()
+	if available <= 0 {
+		return false
+	}
+
+	ratios := make([]float64, len(sizes))
+	for i, size := range sizes {
+		ratios[i] = float64(size) / float64(available)
+	}
+	if slices.Equal(ratios, m.ratios) {
+		return false
+	}
+	m.ratios = ratios
+	return true
+}
+
+//* Resize Event Command
+/*
+ - Returns a command emitting the current sizes and ratios
+*/
+func (m Model) resizeEvent() tea.Cmd {
+	resizeEvent := ResizeEvent{
+		ID:     m.id,
+		Sizes:  m.Sizes(),
+		Ratios: m.Ratios(),
+	}
+	return func() tea.Msg {
+		return resizeEvent
+	}
+}
+
+//* Nested Size Propagation
+/*
+ - Passes the allotted pane sizes down to nested splits
+*/
+func (m *Model) propagate() {
+	sizes := m.Sizes()
+	for i, pane := range m.Panes {
+		if nested, ok := pane.Content.(Model); ok {
+			nested.Width, nested.Height = m.Width, m.Height
+			if m.Orientation == Horizontal {
+				nested.Width = sizes[i]
+			} else {
+				nested.Height = sizes[i]
+			}
+			nested.propagate()
+			m.Panes[i].Content = nested
+		}
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Split View
+/*
+ - Renders the split at its Width and Height
+*/
+func (m Model) View() string {
+	return m.Render(m.Width, m.Height)
+}
+
+//* Render
+/*
+ - Renders each pane at its allotted size, separated by marked dividers
+*/
+func (m Model) Render(width, height int) string {
+	m.sync()
+	m.Width, m.Height = width, height
+	sizes := m.Sizes()
+
+	var parts []string
+	for i, pane := range m.Panes {
+		if i > 0 {
+			parts = append(parts, m.dividerView(m.dividers[i-1]))
+		}
+
+		w, h := width, height
+		if m.Orientation == Horizontal {
+			w = sizes[i]
+		} else {
+			h = sizes[i]
+		}
+
+		content := ""
+		if pane.Content != nil {
+			content = pane.Content.Render(w, h)
+		}
+		parts = append(parts, lipgloss.NewStyle().Width(w).Height(h).MaxWidth(w).MaxHeight(h).Render(content))
+	}
+
+	if m.Orientation == Horizontal {
+		return lipgloss.JoinHorizontal(lipgloss.Top, parts...)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, parts...)
+}
+
+func (m Model) dividerView(d *divider) string {
+	style := m.Styles.Divider
+	if d.interaction.IsDragging {
+		style = m.Styles.DividerDragging
+	} else if d.interaction.IsHovered {
+		style = m.Styles.DividerHover
+	}
+
+	line := strings.TrimSuffix(strings.Repeat("│\n", max(m.Height, 1)), "\n")
+	if m.Orientation == Vertical {
+		line = strings.Repeat("─", max(m.Width, 1))
+	}
+	return zone.Mark(d.interaction.ID, style.Render(line))
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Layout
+/*
+ - Converts the ratios into sizes filling the available space, respecting each pane's Min and Max
+*/
+func (m Model) layout(available int) []int {
+	sizes := make([]int, len(m.Panes))
+	if len(sizes) == 0 || available <= 0 {
+		return sizes
+	}
+
+	used := 0
+	for i, ratio := range m.ratios {
+		sizes[i] = int(ratio * float64(available))
+		used += sizes[i]
+	}
+	sizes[len(sizes)-1] += available - used
+
+	diff := 0
+	for i, pane := range m.Panes {
+		clamped := max(sizes[i], pane.Min)
+		if pane.Max > 0 {
+			clamped = min(clamped, pane.Max)
+		}
+		diff += sizes[i] - clamped
+		sizes[i] = clamped
+	}
+
+	for i, pane := range m.Panes {
+		switch {
+		case diff > 0:
+			give := diff
+			if pane.Max > 0 {
+				give = min(give, pane.Max-sizes[i])
+			}
+			sizes[i] += give
+			diff -= give
+		case diff < 0:
+			take := min(-diff, max(sizes[i]-pane.Min, 0))
+			sizes[i] -= take
+			diff += take
+		}
+	}
+
+	return sizes
+}
+
+func (m Model) available() int {
+	total := m.Width
+	if m.Orientation == Vertical {
+		total = m.Height
+	}
+	return max(total-max(len(m.Panes)-1, 0), 0)
+}
+
+//* Pane Synchronisation
+/*
+ - Rebuilds the ratios and dividers if panes have been added or removed since they were last built
+ - Remaining panes keep their relative ratios and new panes are weighted as the average of the previous panes
+*/
+func (m *Model) sync() {
+	if len(m.ratios) == len(m.Panes) && len(m.dividers) == max(len(m.Panes)-1, 0) {
+		return
+	}
+
+	m.ratios = resized(m.ratios, len(m.Panes))
+	m.defaults = resized(m.defaults, len(m.Panes))
+
+	dividers := make([]*divider, max(len(m.Panes)-1, 0))
+	for i := range dividers {
+		if i < len(m.dividers) {
+			dividers[i] = m.dividers[i]
+		} else {
+			dividers[i] = newDivider(fmt.Sprintf("%sdivider%d", m.id, i), m.Orientation)
+		}
+	}
+	m.dividers = dividers
+
+	m.dragStart, m.dragActive = nil, -1
+}
+
+func resized(ratios []float64, n int) []float64 {
+	if n <= len(ratios) {
+		return normalize(ratios[:n], n)
+	}
+	weights := append([]float64(nil), ratios...)
+	for len(weights) < n {
+		weights = append(weights, 1/float64(max(len(ratios), 1)))
+	}
+	return normalize(weights, n)
+}
+
+func normalize(ratios []float64, n int) []float64 {
+	normalized := make([]float64, n)
+	total := 0.0
+	for i := 0; i < n && i < len(ratios); i++ {
+		total += max(ratios[i], 0)
+	}
+	for i := range normalized {
+		if total <= 0 {
+			normalized[i] = 1 / float64(n)
+		} else if i < len(ratios) {
+			normalized[i] = max(ratios[i], 0) / total
+		}
+	}
+	return normalized
+}
@@ -0,0 +1,49 @@
+package split
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+func TestSplitResize(t *testing.T) {
+	zone.NewGlobal()
+	m := New(Horizontal, Pane{}, Pane{Min: 4})
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 21, Height: 3})
+
+	divider := m.dividers[0].interaction.ID
+	zone.Scan(m.View())
+	for deadline := time.Now().Add(time.Second); zone.Get(divider).IsZero(); time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("zones were not stored")
+		}
+	}
+
+	before := m
+	tests := []struct {
+		name  string
+		msg   tea.MouseMsg
+		sizes []int
+	}{
+		{name: "press", msg: tea.MouseMsg{X: 10, Y: 1, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft}, sizes: []int{10, 10}},
+		{name: "drag", msg: tea.MouseMsg{X: 13, Y: 1, Action: tea.MouseActionMotion, Button: tea.MouseButtonLeft}, sizes: []int{13, 7}},
+		{name: "drag past min", msg: tea.MouseMsg{X: 19, Y: 1, Action: tea.MouseActionMotion, Button: tea.MouseButtonLeft}, sizes: []int{16, 4}},
+		{name: "release", msg: tea.MouseMsg{X: 19, Y: 1, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft}, sizes: []int{16, 4}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m, _ = m.Update(test.msg)
+			if sizes := m.Sizes(); !slices.Equal(sizes, test.sizes) {
+				t.Errorf("sizes %v, want %v", sizes, test.sizes)
+			}
+		})
+	}
+
+	if sizes := before.Sizes(); !slices.Equal(sizes, []int{10, 10}) {
+		t.Errorf("resizing changed an earlier copy of the model to %v", sizes)
+	}
+}
@@ -0,0 +1,557 @@
+package table
+
+import (
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jordanella/teaspoon"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Sort Orders
+/*
+ - Enum cycled by clicking a column header
+*/
+type SortOrder int
+
+const (
+	Unsorted SortOrder = iota
+	Ascending
+	Descending
+)
+
+//* Sort Event
+/*
+ - Emitted when a header click changes the sort order, Column is the column's current index
+*/
+type SortEvent struct {
+	ID     string
+	Column int
+	Order  SortOrder
+}
+
+//* Column Resize Event
+/*
+ - Emitted as a column border handle is dragged
+*/
+type ColumnResizeEvent struct {
+	ID     string
+	Column int
+	Width  int
+}
+
+//* Column Move Event
+/*
+ - Emitted when a header is dragged to a new position
+*/
+type ColumnMoveEvent struct {
+	ID   string
+	From int
+	To   int
+}
+
+//* Row Select Event
+/*
+ - Emitted when row selection changes, Rows are indices into the table's Rows
+*/
+type RowSelectEvent struct {
+	ID   string
+	Rows []int
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Table Column
+/*
+ - A column definition with its title and width
+ - Less compares two cell values for sorting, falling back to string comparison if undefined
+*/
+type Column struct {
+	Title    string
+	Width    int
+	MinWidth int
+	Less     func(a, b string) bool
+}
+
+//* Table Styles
+/*
+ - Styles for header cells, border handles and rows
+*/
+type Styles struct {
+	Header        lipgloss.Style
+	HeaderHover   lipgloss.Style
+	Handle        lipgloss.Style
+	HandleActive  lipgloss.Style
+	Row           lipgloss.Style
+	RowHover      lipgloss.Style
+	RowSelected   lipgloss.Style
+	HeaderDragged lipgloss.Style
+}
+
+//* Default Table Styles
+/*
+ - Returns bold headers and highlighted hovered and selected rows
+*/
+func DefaultStyles() Styles {
+	return Styles{
+		Header:        lipgloss.NewStyle().Bold(true),
+		HeaderHover:   lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#44aaaa")),
+		HeaderDragged: lipgloss.NewStyle().Bold(true).Faint(true),
+		Handle:        lipgloss.NewStyle().Faint(true),
+		HandleActive:  lipgloss.NewStyle().Foreground(lipgloss.Color("#44aaaa")),
+		Row:           lipgloss.NewStyle(),
+		RowHover:      lipgloss.NewStyle().Foreground(lipgloss.Color("#44aaaa")),
+		RowSelected:   lipgloss.NewStyle().Reverse(true),
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Table Element
+/*
+ - Interactive header cell, border handle or row
+*/
+type element struct {
+	index       int
+	pressed     bool
+	interaction *teaspoon.Interactable
+}
+
+func (e *element) GetInteraction() *teaspoon.Interactable {
+	return e.interaction
+}
+
+func newElement(index int, click *teaspoon.ClickHandler, drag bool) *element {
+	e := &element{
+		index: index,
+		interaction: &teaspoon.Interactable{
+			ID:    zone.NewPrefix(),
+			Hover: &teaspoon.HoverHandler{},
+			Click: click,
+		},
+	}
+	if drag {
+		e.interaction.Drag = &teaspoon.DragHandler{}
+	}
+	return e
+}
+
+func pressClick() *teaspoon.ClickHandler {
+	return &teaspoon.ClickHandler{
+		OnClick: func(interactive teaspoon.Interactive, mouseMsg tea.MouseMsg) (teaspoon.Interactive, tea.Cmd) {
+			interactive.(*element).pressed = true
+			return interactive, nil
+		},
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Table Model
+/*
+ - Clicking a header cycles its sort order, dragging a header moves the column
+ - Dragging the handle after a header resizes its column within MinWidth
+ - Rows are selected through a selection group, so Ctrl and Shift clicks apply in MultipleSelection mode
+ - Height limits the visible rows, which scroll with the wheel
+*/
+type Model struct {
+	Columns []Column
+	Rows    [][]string
+	Height  int
+	Styles  Styles
+
+	id        string
+	sortBy    int
+	order     SortOrder
+	offset    int
+	view      []int
+	headers   []*element
+	handles   []*element
+	rows      []*element
+	selection *teaspoon.SelectionGroup
+	resizing  int
+	startSize int
+}
+
+//* Creation Method
+/*
+ - Returns a new table with the given columns and rows, selecting a single row at a time
+*/
+func New(columns []Column, rows [][]string) Model {
+	m := Model{
+		Styles:    DefaultStyles(),
+		id:        zone.NewPrefix(),
+		sortBy:    -1,
+		resizing:  -1,
+		selection: teaspoon.NewSelectionGroup(teaspoon.SingleSelection),
+	}
+	m = m.SetColumns(columns)
+	return m.SetRows(rows)
+}
+
+//* Table ID
+/*
+ - Returns the ID attached to the table's events
+*/
+func (m Model) ID() string {
+	return m.id
+}
+
+//* Selection Group
+/*
+ - Returns the group coordinating row selection, whose Mode may be changed to MultipleSelection
+*/
+func (m Model) Selection() *teaspoon.SelectionGroup {
+	return m.selection
+}
+
+//* Set Columns
+/*
+ - Replaces the columns, creating a header cell and border handle for each
+*/
+func (m Model) SetColumns(columns []Column) Model {
+	m.Columns = columns
+	m.headers, m.handles = nil, nil
+	for i := range columns {
+		m.headers = append(m.headers, newElement(i, pressClick(), true))
+		handle := newElement(i, pressClick(), true)
+		handle.interaction.Cursor = teaspoon.EWResizeCursor
+		m.handles = append(m.handles, handle)
+	}
+	return m
+}
+
+//* Set Rows
+/*
+ - Replaces the rows, clearing the selection and reapplying the current sort
+*/
+func (m Model) SetRows(rows [][]string) Model {
+	m.Rows = rows
+	m.rows = nil
+	members := make([]teaspoon.Interactive, len(rows))
+	for i := range rows {
+		click := &teaspoon.ClickHandler{OnClick: m.selection.HandleClick}
+		m.rows = append(m.rows, newElement(i, click, false))
+	}
+	m.selection.Clear()
+	m.sort()
+	for i, index := range m.view {
+		members[i] = m.rows[index]
+	}
+	m.selection.SetMembers(members...)
+	return m
+}
+
+//* Selected Rows
+/*
+ - Returns the indices into Rows of the selected rows in display order
+*/
+func (m Model) SelectedRows() []int {
+	var rows []int
+	for _, member := range m.selection.Selected() {
+		rows = append(rows, member.(*element).index)
+	}
+	return rows
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Update Routine
+/*
+ - Mouse messages are passed to the headers, handles and visible rows, then resolved into table events
+ - Other messages are passed to the headers and handles to cancel drags
+*/
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+
+	mouseMsg, ok := msg.(tea.MouseMsg)
+	if !ok {
+		for _, e := range append(append([]*element{}, m.headers...), m.handles...) {
+			if _, cmd = e.interaction.HandleExternalEvent(e, msg); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+		return m, tea.Batch(cmds...)
+	}
+
+	if tea.MouseEvent(mouseMsg).IsWheel() {
+		if mouseMsg.Action == tea.MouseActionPress && zone.Get(m.id).InBounds(mouseMsg) {
+			switch mouseMsg.Button {
+			case tea.MouseButtonWheelUp:
+				m.offset = max(m.offset-1, 0)
+			case tea.MouseButtonWheelDown:
+				m.offset = max(min(m.offset+1, len(m.view)-m.Height), 0)
+			}
+		}
+		return m, nil
+	}
+
+	headersDragging := make([]bool, len(m.headers))
+	for i, header := range m.headers {
+		headersDragging[i] = header.interaction.IsDragging
+	}
+	selected := m.selection.SelectedIDs()
+
+	for _, e := range m.interactive() {
+		if _, cmd = e.interaction.HandleMouseMsg(e, mouseMsg); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	for i, handle := range m.handles {
+		handle.pressed = false
+		switch {
+		case handle.interaction.IsDragging && m.resizing != i:
+			m.resizing = i
+			m.startSize = m.Columns[i].Width
+		case handle.interaction.IsDragging:
+			width := max(m.startSize+handle.interaction.DragOffset.X, m.Columns[i].MinWidth, 1)
+			if width != m.Columns[i].Width {
+				m.Columns[i].Width = width
+				resizeEvent := ColumnResizeEvent{ID: m.id, Column: i, Width: width}
+				cmds = append(cmds, func() tea.Msg {
+					return resizeEvent
+				})
+			}
+		case m.resizing == i:
+			m.resizing = -1
+		}
+	}
+
+	for i, header := range m.headers {
+		header.pressed = false
+		if !headersDragging[i] || header.interaction.IsDragging || mouseMsg.Action != tea.MouseActionRelease {
+			continue
+		}
+		if header.interaction.DragOffset.X == 0 {
+			m, cmd = m.cycleSort(i)
+		} else {
+			m, cmd = m.MoveColumn(i, m.columnAt(header.interaction.DragPosition()))
+		}
+		cmds = append(cmds, cmd)
+	}
+
+	if current := m.selection.SelectedIDs(); strings.Join(current, ",") != strings.Join(selected, ",") {
+		rowSelectEvent := RowSelectEvent{ID: m.id, Rows: m.SelectedRows()}
+		cmds = append(cmds, func() tea.Msg {
+			return rowSelectEvent
+		})
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+//* Move Column
+/*
+ - Moves the column at index from to index to, along with its cells, and emits a ColumnMoveEvent
+*/
+func (m Model) MoveColumn(from, to int) (Model, tea.Cmd) {
+	if from < 0 || from >= len(m.Columns) || to < 0 || to >= len(m.Columns) || from == to {
+		return m, nil
+	}
+
+	m.Columns = move(m.Columns, from, to)
+	m.headers = move(m.headers, from, to)
+	m.handles = move(m.handles, from, to)
+	m.Rows = append([][]string{}, m.Rows...)
+	for i := range m.Rows {
+		if from < len(m.Rows[i]) && to < len(m.Rows[i]) {
+			m.Rows[i] = move(m.Rows[i], from, to)
+		}
+	}
+	if m.sortBy >= 0 {
+		order := make([]int, len(m.Columns))
+		for i := range order {
+			order[i] = i
+		}
+		order = move(order, from, to)
+		for i, column := range order {
+			if column == m.sortBy {
+				m.sortBy = i
+				break
+			}
+		}
+	}
+
+	columnMoveEvent := ColumnMoveEvent{ID: m.id, From: from, To: to}
+	return m, func() tea.Msg {
+		return columnMoveEvent
+	}
+}
+
+//* Sort Cycling
+/*
+ - Advances the sort order of the column, resetting other columns, and emits a SortEvent
+*/
+func (m Model) cycleSort(column int) (Model, tea.Cmd) {
+	if m.sortBy != column {
+		m.sortBy, m.order = column, Ascending
+	} else {
+		m.order = (m.order + 1) % 3
+	}
+	m.sort()
+
+	members := make([]teaspoon.Interactive, len(m.view))
+	for i, index := range m.view {
+		members[i] = m.rows[index]
+	}
+	m.selection.SetMembers(members...)
+
+	sortEvent := SortEvent{ID: m.id, Column: column, Order: m.order}
+	return m, func() tea.Msg {
+		return sortEvent
+	}
+}
+
+//* Sort
+/*
+ - Rebuilds the display order of the rows from the current sort column and order
+*/
+func (m *Model) sort() {
+	m.view = make([]int, len(m.Rows))
+	for i := range m.view {
+		m.view[i] = i
+	}
+	if m.sortBy < 0 || m.order == Unsorted {
+		return
+	}
+
+	column := m.Columns[m.sortBy]
+	less := column.Less
+	if less == nil {
+		less = func(a, b string) bool { return a < b }
+	}
+	cell := func(row int) string {
+		if m.sortBy < len(m.Rows[row]) {
+			return m.Rows[row][m.sortBy]
+		}
+		return ""
+	}
+
+	sort.SliceStable(m.view, func(i, j int) bool {
+		a, b := cell(m.view[i]), cell(m.view[j])
+		if m.order == Descending {
+			return less(b, a)
+		}
+		return less(a, b)
+	})
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Table View
+/*
+ - Renders the header with sort indicators and border handles, followed by the visible rows
+*/
+func (m Model) View() string {
+	var header []string
+	for i, column := range m.Columns {
+		interaction := m.headers[i].interaction
+		style := m.Styles.Header
+		switch {
+		case interaction.IsDragging && interaction.DragOffset.X != 0:
+			style = m.Styles.HeaderDragged
+		case interaction.IsHovered:
+			style = m.Styles.HeaderHover
+		}
+
+		title := column.Title
+		if i == m.sortBy && m.order == Ascending {
+			title += " ▲"
+		} else if i == m.sortBy && m.order == Descending {
+			title += " ▼"
+		}
+		header = append(header, zone.Mark(interaction.ID, style.Render(fit(title, column.Width))))
+
+		handle := m.handles[i].interaction
+		handleStyle := m.Styles.Handle
+		if handle.IsDragging || handle.IsHovered {
+			handleStyle = m.Styles.HandleActive
+		}
+		header = append(header, zone.Mark(handle.ID, handleStyle.Render("│")))
+	}
+
+	lines := []string{strings.Join(header, "")}
+	start, end := m.visibleRange()
+	for _, index := range m.view[start:end] {
+		row := m.rows[index]
+		style := m.Styles.Row
+		switch {
+		case row.interaction.IsSelected:
+			style = m.Styles.RowSelected
+		case row.interaction.IsHovered:
+			style = m.Styles.RowHover
+		}
+
+		var cells []string
+		for i, column := range m.Columns {
+			cell := ""
+			if i < len(m.Rows[index]) {
+				cell = m.Rows[index][i]
+			}
+			cells = append(cells, fit(cell, column.Width))
+		}
+		lines = append(lines, zone.Mark(row.interaction.ID, style.Render(strings.Join(cells, " "))))
+	}
+
+	return zone.Mark(m.id, strings.Join(lines, "\n"))
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Table Helpers
+/*
+ - Resolve visible rows, the column under the pointer and cell fitting
+*/
+func (m Model) interactive() []*element {
+	elements := append(append([]*element{}, m.handles...), m.headers...)
+	start, end := m.visibleRange()
+	for _, index := range m.view[start:end] {
+		elements = append(elements, m.rows[index])
+	}
+	return elements
+}
+
+func (m Model) visibleRange() (int, int) {
+	if m.Height <= 0 {
+		return 0, len(m.view)
+	}
+	start := max(0, min(m.offset, len(m.view)-m.Height))
+	return start, min(start+m.Height, len(m.view))
+}
+
+func (m Model) columnAt(mouseMsg tea.MouseMsg) int {
+	for i, header := range m.headers {
+		bounds := zone.Get(header.interaction.ID)
+		if bounds.IsZero() {
+			continue
+		}
+		if mouseMsg.X <= bounds.EndX+1 {
+			return i
+		}
+	}
+	return len(m.headers) - 1
+}
+
+func fit(text string, width int) string {
+	width = max(width, 1)
+	if lipgloss.Width(text) > width {
+		runes := []rune(text)
+		for len(runes) > 0 && lipgloss.Width(string(runes))+1 > width {
+			runes = runes[:len(runes)-1]
+		}
+		return string(runes) + "…"
+	}
+	return text + strings.Repeat(" ", width-lipgloss.Width(text))
+}
+
+func move[T any](items []T, from, to int) []T {
+	item := items[from]
+	rest := append(append([]T{}, items[:from]...), items[from+1:]...)
+	return append(rest[:to:to], append([]T{item}, rest[to:]...)...)
+}
@@ -0,0 +1,99 @@
+package table
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+// Scans the view and waits for the zone with the given ID to be stored again
+func scan(t *testing.T, view, id string) {
+	t.Helper()
+	zone.Clear(id)
+	zone.Scan(view)
+	for deadline := time.Now().Add(time.Second); zone.Get(id).IsZero(); time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("zones were not stored")
+		}
+	}
+}
+
+// Presses the header at from and releases it at to, returning the event emitted by the release
+func dragHeader(m Model, from, to int) (Model, tea.Msg) {
+	m, _ = m.Update(tea.MouseMsg{X: from, Y: 0, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	if to != from {
+		m, _ = m.Update(tea.MouseMsg{X: to, Y: 0, Action: tea.MouseActionMotion, Button: tea.MouseButtonLeft})
+	}
+	m, cmd := m.Update(tea.MouseMsg{X: to, Y: 0, Action: tea.MouseActionRelease, Button: tea.MouseButtonLeft})
+	if cmd == nil {
+		return m, nil
+	}
+	return m, cmd()
+}
+
+// Returns the displayed rows in order, joined into single strings
+func displayed(m Model) []string {
+	var rows []string
+	for _, index := range m.view {
+		row := ""
+		for _, cell := range m.Rows[index] {
+			row += cell
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func TestTableHeaders(t *testing.T) {
+	zone.NewGlobal()
+	m := New(
+		[]Column{{Title: "Name", Width: 4}, {Title: "Age", Width: 3}},
+		[][]string{{"bob", "30"}, {"amy", "25"}, {"cat", "41"}},
+	)
+	scan(t, m.View(), m.handles[1].interaction.ID)
+
+	tests := []struct {
+		name     string
+		from, to int
+		event    tea.Msg
+		rows     []string
+		columns  []string
+	}{
+		{name: "sort ascending", from: 1, to: 1, event: SortEvent{ID: m.id, Column: 0, Order: Ascending},
+			rows: []string{"amy25", "bob30", "cat41"}, columns: []string{"Name", "Age"}},
+		{name: "sort descending", from: 1, to: 1, event: SortEvent{ID: m.id, Column: 0, Order: Descending},
+			rows: []string{"cat41", "bob30", "amy25"}, columns: []string{"Name", "Age"}},
+		{name: "move sorted column", from: 1, to: 6, event: ColumnMoveEvent{ID: m.id, From: 0, To: 1},
+			rows: []string{"41cat", "30bob", "25amy"}, columns: []string{"Age", "Name"}},
+		{name: "sort another column", from: 1, to: 1, event: SortEvent{ID: m.id, Column: 0, Order: Ascending},
+			rows: []string{"25amy", "30bob", "41cat"}, columns: []string{"Age", "Name"}},
+		{name: "sort another column descending", from: 1, to: 1, event: SortEvent{ID: m.id, Column: 0, Order: Descending},
+			rows: []string{"41cat", "30bob", "25amy"}, columns: []string{"Age", "Name"}},
+		{name: "unsorted after a full cycle", from: 1, to: 1, event: SortEvent{ID: m.id, Column: 0, Order: Unsorted},
+			rows: []string{"30bob", "25amy", "41cat"}, columns: []string{"Age", "Name"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var event tea.Msg
+			m, event = dragHeader(m, test.from, test.to)
+			if event != test.event {
+				t.Errorf("event %+v, want %+v", event, test.event)
+			}
+			if rows := displayed(m); !slices.Equal(rows, test.rows) {
+				t.Errorf("rows %v, want %v", rows, test.rows)
+			}
+			var columns []string
+			for _, column := range m.Columns {
+				columns = append(columns, column.Title)
+			}
+			if !slices.Equal(columns, test.columns) {
+				t.Errorf("columns %v, want %v", columns, test.columns)
+			}
+			scan(t, m.View(), m.handles[1].interaction.ID)
+		})
+	}
+}
@@ -0,0 +1,502 @@
+package tabs
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jordanella/teaspoon"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Select Event
+/*
+ - Emitted when a tab is selected by clicking it
+*/
+type SelectEvent struct {
+	ID    string
+	TabID string
+	Index int
+}
+
+//* Close Event
+/*
+ - Emitted when a tab is closed with its close glyph or a middle click, after it has been removed
+*/
+type CloseEvent struct {
+	ID    string
+	TabID string
+	Index int
+}
+
+//* Reorder Event
+/*
+ - Emitted when a tab has been dragged to a new position
+*/
+type ReorderEvent struct {
+	ID    string
+	TabID string
+	From  int
+	To    int
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Tab Bar Styles
+/*
+ - Styles for tabs in their normal, hovered, selected and dragged states
+*/
+type Styles struct {
+	Tab       lipgloss.Style
+	Hovered   lipgloss.Style
+	Selected  lipgloss.Style
+	Dragging  lipgloss.Style
+	Close     lipgloss.Style
+	Indicator lipgloss.Style
+}
+
+//* Default Tab Bar Styles
+/*
+ - Returns padded tabs with a highlighted selected tab and a faint close glyph
+*/
+func DefaultStyles() Styles {
+	tab := lipgloss.NewStyle().Padding(0, 1)
+	return Styles{
+		Tab:       tab.Faint(true),
+		Hovered:   tab.Foreground(lipgloss.Color("#44aaaa")),
+		Selected:  tab.Bold(true).Underline(true),
+		Dragging:  tab.Faint(true).Italic(true),
+		Close:     lipgloss.NewStyle().Faint(true),
+		Indicator: lipgloss.NewStyle().Foreground(lipgloss.Color("#44aaaa")),
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Tab
+/*
+ - A single interactive tab, selected by clicking and closed with its close glyph or a middle click
+*/
+type Tab struct {
+	Title    string
+	Closable bool
+
+	closing     bool
+	interaction *teaspoon.Interactable
+	close       *closeGlyph
+}
+
+//* Implement Interactive Interface
+/*
+ - Provides reference to the tab's interaction
+*/
+func (t *Tab) GetInteraction() *teaspoon.Interactable {
+	return t.interaction
+}
+
+//* Close Glyph
+/*
+ - Clickable glyph closing its tab
+*/
+type closeGlyph struct {
+	tab         *Tab
+	interaction *teaspoon.Interactable
+}
+
+func (c *closeGlyph) GetInteraction() *teaspoon.Interactable {
+	return c.interaction
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Tab Bar
+/*
+ - Row of tabs with single selection, closing, drag to reorder and wheel scrolling when tabs overflow Width
+ - Clicking a tab selects it regardless of modifier keys and scrolls it into view, so one tab is always selected
+*/
+type Model struct {
+	Width  int
+	Styles Styles
+
+	tabs        []*Tab
+	offset      int
+	insert      int
+	selection   *teaspoon.SelectionGroup
+	drag        *teaspoon.DragHandler
+	interaction *teaspoon.Interactable
+}
+
+//* Creation Method
+/*
+ - Returns a new tab bar with a closable tab for each title, selecting the first
+*/
+func New(titles ...string) Model {
+	id := zone.NewPrefix()
+
+	drop := &teaspoon.DropHandler{
+		AcceptedDropTypes: []string{id},
+	}
+	drop.OnDropHover = func(element teaspoon.Interactive, dragEvent teaspoon.DragEvent) (teaspoon.Interactive, tea.Cmd) {
+		m := element.(Model)
+		m.insert = m.insertionIndex(dragEvent.MouseMsg, m.indexOf(dragEvent.ID))
+		return drop.DefaultDropHover(m, dragEvent)
+	}
+	drop.OnDropLeave = func(element teaspoon.Interactive, dragEvent teaspoon.DragEvent) (teaspoon.Interactive, tea.Cmd) {
+		m := element.(Model)
+		m.insert = -1
+		return drop.DefaultDropLeave(m, dragEvent)
+	}
+	drop.OnDropRelease = func(element teaspoon.Interactive, dragEvent teaspoon.DragEvent) (teaspoon.Interactive, tea.Cmd) {
+		m := element.(Model)
+		m.insert = -1
+		var cmd tea.Cmd
+		if from := m.indexOf(dragEvent.ID); from >= 0 && drop.HandleIsAcceptable(m, dragEvent) {
+			to := m.insertionIndex(dragEvent.MouseMsg, from)
+			if to > from {
+				to--
+			}
+			m, cmd = m.MoveTab(from, to)
+		}
+		element, releaseCmd := drop.DefaultDropRelease(m, dragEvent)
+		return element, tea.Batch(cmd, releaseCmd)
+	}
+
+	m := Model{
+		Width:     80,
+		Styles:    DefaultStyles(),
+		insert:    -1,
+		selection: teaspoon.NewSelectionGroup(teaspoon.SingleSelection),
+		drag: &teaspoon.DragHandler{
+			DragType:     id,
+			EmitMessages: true,
+		},
+		interaction: &teaspoon.Interactable{
+			ID:   id,
+			Drop: drop,
+		},
+	}
+	for _, title := range titles {
+		m = m.AddTab(title)
+	}
+	if len(m.tabs) > 0 {
+		m.selection.Select(m.tabs[0].interaction.ID)
+	}
+	return m
+}
+
+//* Implement Interactive Interface
+/*
+ - Provides reference to the tab bar's interaction, used as the drop target for its tabs
+*/
+func (m Model) GetInteraction() *teaspoon.Interactable {
+	return m.interaction
+}
+
+//* Add Tab
+/*
+ - Appends a closable tab with the given title
+*/
+func (m Model) AddTab(title string) Model {
+	tab := &Tab{
+		Title:    title,
+		Closable: true,
+	}
+
+	click := func(element teaspoon.Interactive, mouseMsg tea.MouseMsg) (teaspoon.Interactive, tea.Cmd) {
+		tab := element.(*Tab)
+		if mouseMsg.Button == tea.MouseButtonMiddle {
+			tab.closing = tab.Closable
+			return element, nil
+		}
+		if tab.close.interaction.HandleIsInside(tab.close, mouseMsg) {
+			return element, nil
+		}
+		m.selection.Select(tab.interaction.ID)
+		return element, nil
+	}
+
+	tab.interaction = &teaspoon.Interactable{
+		ID:    zone.NewPrefix(),
+		Hover: &teaspoon.HoverHandler{},
+		Drag:  m.drag,
+		Click: &teaspoon.ClickHandler{
+			OnClick: click,
+		},
+	}
+
+	tab.close = &closeGlyph{
+		tab: tab,
+		interaction: &teaspoon.Interactable{
+			ID: zone.NewPrefix(),
+			Click: &teaspoon.ClickHandler{
+				OnClick: func(element teaspoon.Interactive, mouseMsg tea.MouseMsg) (teaspoon.Interactive, tea.Cmd) {
+					glyph := element.(*closeGlyph)
+					glyph.tab.closing = glyph.tab.Closable
+					return element, nil
+				},
+			},
+		},
+	}
+
+	m.tabs = append(m.tabs, tab)
+	m.selection.Add(tab)
+	return m
+}
+
+//* Tabs
+/*
+ - Returns the tabs in order
+*/
+func (m Model) Tabs() []*Tab {
+	return m.tabs
+}
+
+//* Selected Index
+/*
+ - Returns the index of the selected tab, or -1 if there are no tabs
+*/
+func (m Model) Selected() int {
+	for i, tab := range m.tabs {
+		if tab.interaction.IsSelected {
+			return i
+		}
+	}
+	return -1
+}
+
+//* Select Tab
+/*
+ - Selects the tab at the given index and scrolls it into view
+*/
+func (m Model) Select(index int) Model {
+	if index >= 0 && index < len(m.tabs) {
+		m.selection.Select(m.tabs[index].interaction.ID)
+		m.scrollTo(index)
+	}
+	return m
+}
+
+//* Move Tab
+/*
+ - Moves the tab at index from to index to and returns a command emitting a ReorderEvent
+*/
+func (m Model) MoveTab(from, to int) (Model, tea.Cmd) {
+	if from < 0 || from >= len(m.tabs) || to < 0 || to >= len(m.tabs) || from == to {
+		return m, nil
+	}
+
+	tab := m.tabs[from]
+	tabs := append(append([]*Tab{}, m.tabs[:from]...), m.tabs[from+1:]...)
+	m.tabs = append(tabs[:to:to], append([]*Tab{tab}, tabs[to:]...)...)
+	m.syncSelection()
+
+	reorderEvent := ReorderEvent{ID: m.interaction.ID, TabID: tab.interaction.ID, From: from, To: to}
+	return m, func() tea.Msg {
+		return reorderEvent
+	}
+}
+
+//* Close Tab
+/*
+ - Removes the tab at the given index, selecting a neighbour if it was selected
+ - Returns a command emitting a CloseEvent
+*/
+func (m Model) CloseTab(index int) (Model, tea.Cmd) {
+	if index < 0 || index >= len(m.tabs) {
+		return m, nil
+	}
+
+	tab := m.tabs[index]
+	wasSelected := tab.interaction.IsSelected
+	m.tabs = append(append([]*Tab{}, m.tabs[:index]...), m.tabs[index+1:]...)
+	m.selection.Remove(tab.interaction.ID)
+	m.syncSelection()
+	m.offset = max(0, min(m.offset, len(m.tabs)-1))
+
+	var cmds []tea.Cmd
+	if wasSelected && len(m.tabs) > 0 {
+		next := min(index, len(m.tabs)-1)
+		m = m.Select(next)
+		cmds = append(cmds, m.selectCmd(next))
+	}
+
+	closeEvent := CloseEvent{ID: m.interaction.ID, TabID: tab.interaction.ID, Index: index}
+	cmds = append(cmds, func() tea.Msg {
+		return closeEvent
+	})
+	return m, tea.Batch(cmds...)
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Update Routine
+/*
+ - Wheel messages over the bar scroll overflowing tabs
+ - Mouse messages are passed to every tab and close glyph, then resolved into selection and close events
+ - Drag events are assessed by the bar for reordering, other messages are passed to the tabs to cancel drags
+*/
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.MouseMsg:
+		if tea.MouseEvent(msg).IsWheel() {
+			if msg.Action == tea.MouseActionPress && zone.Get(m.interaction.ID).InBounds(msg) {
+				switch msg.Button {
+				case tea.MouseButtonWheelUp, tea.MouseButtonWheelLeft:
+					m.offset = max(m.offset-1, 0)
+				case tea.MouseButtonWheelDown, tea.MouseButtonWheelRight:
+					m.offset = max(min(m.offset+1, len(m.tabs)-1), 0)
+				}
+			}
+			return m, nil
+		}
+
+		selected := m.Selected()
+		for _, tab := range append([]*Tab{}, m.tabs...) {
+			if tab.Closable {
+				if _, cmd = tab.close.interaction.HandleMouseMsg(tab.close, msg); cmd != nil {
+					cmds = append(cmds, cmd)
+				}
+			}
+			if _, cmd = tab.interaction.HandleMouseMsg(tab, msg); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+
+		for i := 0; i < len(m.tabs); i++ {
+			if m.tabs[i].closing {
+				m.tabs[i].closing = false
+				m, cmd = m.CloseTab(i)
+				cmds = append(cmds, cmd)
+				i--
+			}
+		}
+		if index := m.Selected(); index != selected && index >= 0 {
+			m.scrollTo(index)
+			cmds = append(cmds, m.selectCmd(index))
+		}
+		return m, tea.Batch(cmds...)
+
+	case teaspoon.DragEvent:
+		var element teaspoon.Interactive
+		element, cmd = m.interaction.HandleExternalEvent(m, msg)
+		m = element.(Model)
+		cmds = append(cmds, cmd)
+	}
+
+	for _, tab := range m.tabs {
+		if _, cmd = tab.interaction.HandleExternalEvent(tab, msg); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Tab Bar View
+/*
+ - Renders the tabs from the scroll offset which fit within Width, with a reorder indicator while dragging
+*/
+func (m Model) View() string {
+	indicator := m.Styles.Indicator.Render("│")
+
+	var parts []string
+	width := 0
+	for i := m.offset; i < len(m.tabs); i++ {
+		rendered := m.tabView(m.tabs[i])
+		if i == m.insert {
+			rendered = indicator + rendered
+		}
+		if width+lipgloss.Width(rendered) > m.Width && len(parts) > 0 {
+			break
+		}
+		width += lipgloss.Width(rendered)
+		parts = append(parts, rendered)
+	}
+	if m.insert >= len(m.tabs) {
+		parts = append(parts, indicator)
+	}
+
+	return zone.Mark(m.interaction.ID, lipgloss.JoinHorizontal(lipgloss.Top, parts...))
+}
+
+func (m Model) tabView(tab *Tab) string {
+	interaction := tab.interaction
+
+	style := m.Styles.Tab
+	switch {
+	case interaction.IsDragging:
+		style = m.Styles.Dragging
+	case interaction.IsSelected:
+		style = m.Styles.Selected
+	case interaction.IsHovered:
+		style = m.Styles.Hovered
+	}
+
+	label := tab.Title
+	if tab.Closable {
+		label += " " + zone.Mark(tab.close.interaction.ID, m.Styles.Close.Render("×"))
+	}
+	return zone.Mark(interaction.ID, style.Render(label))
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Tab Helpers
+/*
+ - Resolve the insertion index under the pointer and keep the selection group in tab order
+*/
+func (m Model) insertionIndex(mouseMsg tea.MouseMsg, from int) int {
+	start := min(m.offset, len(m.tabs))
+	visible := make([]teaspoon.Interactive, 0, len(m.tabs)-start)
+	for _, tab := range m.tabs[start:] {
+		visible = append(visible, tab)
+	}
+	return teaspoon.InsertionIndex(teaspoon.XAxis, mouseMsg, from, start, visible...)
+}
+
+func (m *Model) syncSelection() {
+	members := make([]teaspoon.Interactive, len(m.tabs))
+	for i, tab := range m.tabs {
+		members[i] = tab
+	}
+	m.selection.SetMembers(members...)
+}
+
+func (m *Model) scrollTo(index int) {
+	if index < m.offset {
+		m.offset = index
+		return
+	}
+	for m.offset < index && m.visibleWidth(m.offset, index) > m.Width {
+		m.offset++
+	}
+}
+
+func (m Model) visibleWidth(from, to int) int {
+	width := 0
+	for i := from; i <= to && i < len(m.tabs); i++ {
+		width += lipgloss.Width(m.tabView(m.tabs[i]))
+	}
+	return width
+}
+
+func (m Model) selectCmd(index int) tea.Cmd {
+	selectEvent := SelectEvent{ID: m.interaction.ID, TabID: m.tabs[index].interaction.ID, Index: index}
+	return func() tea.Msg {
+		return selectEvent
+	}
+}
+
+func (m Model) indexOf(id string) int {
+	for i, tab := range m.tabs {
+		if tab.interaction.ID == id {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,256 @@
+package teaspoon
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Toggle Events
+/*
+ - Emitted whenever a toggle, radio or checkbox behaviour changes the checked state of an element
+ - Indeterminate is only set by tri-state checkboxes, in which case Checked is false
+*/
+type ToggleEvent struct {
+	ID            string
+	Checked       bool
+	Indeterminate bool
+}
+
+//* Check States
+/*
+ - Enum describing the state of a tri-state checkbox
+*/
+type CheckState int
+
+const (
+	Unchecked CheckState = iota
+	Checked
+	Indeterminate
+)
+
+func toggled(interaction *Interactable, indeterminate bool) tea.Cmd {
+	toggleEvent := ToggleEvent{
+		ID:            interaction.ID,
+		Checked:       interaction.IsSelected,
+		Indeterminate: indeterminate,
+	}
+	return func() tea.Msg {
+		return toggleEvent
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Toggle Click Behaviour
+/*
+ - Clickable which flips an element's IsSelected property, suitable for checkboxes and toggle switches
+ - Double clicks and right clicks are ignored, as every press of a double click is already a click
+*/
+type ToggleClickBehaviour struct{}
+
+//* Toggle Click Handlers
+/*
+ - Flips IsSelected on clicks and emits a ToggleEvent
+*/
+func (b *ToggleClickBehaviour) HandleClick(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
+	interaction := element.GetInteraction()
+	interaction.IsSelected = !interaction.IsSelected
+	return element, toggled(interaction, false)
+}
+
+func (b *ToggleClickBehaviour) HandleDoubleClick(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
+	return element, nil
+}
+
+func (b *ToggleClickBehaviour) HandleRightClick(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
+	return element, nil
+}
+
+//* Tri-State Click Behaviour
+/*
+ - Clickable for checkboxes which may also be indeterminate, such as a parent of partially checked children
+ - Clicking an indeterminate or unchecked box checks it, clicking a checked box unchecks it
+ - When CycleIndeterminate is true clicking a checked box makes it indeterminate instead
+ - IsSelected is kept true only while State is Checked
+*/
+type TriStateClickBehaviour struct {
+	State              CheckState
+	CycleIndeterminate bool
+}
+
+//* Set Tri-State
+/*
+ - Sets the state of the checkbox without emitting an event, keeping IsSelected in step
+*/
+func (b *TriStateClickBehaviour) SetState(element Interactive, state CheckState) {
+	b.State = state
+	element.GetInteraction().IsSelected = state == Checked
+}
+
+//* Tri-State Click Handlers
+/*
+ - Advances State on clicks and emits a ToggleEvent
+*/
+func (b *TriStateClickBehaviour) HandleClick(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
+	switch {
+	case b.State != Checked:
+		b.SetState(element, Checked)
+	case b.CycleIndeterminate:
+		b.SetState(element, Indeterminate)
+	default:
+		b.SetState(element, Unchecked)
+	}
+	return element, toggled(element.GetInteraction(), b.State == Indeterminate)
+}
+
+func (b *TriStateClickBehaviour) HandleDoubleClick(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
+	return element, nil
+}
+
+func (b *TriStateClickBehaviour) HandleRightClick(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
+	return element, nil
+}
+
+//* Radio Click Behaviour
+/*
+ - Clickable which checks an element and unchecks the other members of its selection group
+ - Every radio button of a set should share the same behaviour, and be members of its Group
+ - Clicking a checked radio button leaves it checked, events are emitted for both the checked and unchecked members
+*/
+type RadioClickBehaviour struct {
+	Group *SelectionGroup
+}
+
+//* Radio Creation Method
+/*
+ - Returns a radio behaviour with a new single selection group of the given members
+*/
+func NewRadioClickBehaviour(members ...Interactive) *RadioClickBehaviour {
+	return &RadioClickBehaviour{
+		Group: NewSelectionGroup(SingleSelection, members...),
+	}
+}
+
+//* Radio Click Handlers
+/*
+ - Selects the element within Group on clicks
+*/
+func (b *RadioClickBehaviour) HandleClick(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
+	interaction := element.GetInteraction()
+	if interaction.IsSelected {
+		return element, nil
+	}
+
+	previous := b.Group.Selected()
+	b.Group.Select(interaction.ID)
+
+	cmds := []tea.Cmd{toggled(interaction, false)}
+	for _, member := range previous {
+		cmds = append(cmds, toggled(member.GetInteraction(), false))
+	}
+	return element, tea.Batch(cmds...)
+}
+
+func (b *RadioClickBehaviour) HandleDoubleClick(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
+	return element, nil
+}
+
+func (b *RadioClickBehaviour) HandleRightClick(element Interactive, mouseMsg tea.MouseMsg) (Interactive, tea.Cmd) {
+	return element, nil
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Toggle Styles
+/*
+ - Styles and glyphs used by the checkbox, radio and switch render helpers
+ - Glyph pairs are ordered unchecked then checked, with a third indeterminate glyph for checkboxes
+*/
+type ToggleStyles struct {
+	Normal   lipgloss.Style
+	Hovered  lipgloss.Style
+	Checked  lipgloss.Style
+	Disabled lipgloss.Style
+
+	Checkbox [3]string
+	Radio    [2]string
+	Switch   [2]string
+}
+
+//* Default Toggle Styles
+/*
+ - Returns bracketed checkboxes and radio buttons, with hovered and checked elements in the accent colour
+*/
+func DefaultToggleStyles() ToggleStyles {
+	return ToggleStyles{
+		Normal:   lipgloss.NewStyle(),
+		Hovered:  lipgloss.NewStyle().Foreground(lipgloss.Color("#44aaaa")),
+		Checked:  lipgloss.NewStyle().Foreground(lipgloss.Color("#44aaaa")).Bold(true),
+		Disabled: lipgloss.NewStyle().Faint(true),
+		Checkbox: [3]string{"[ ]", "[x]", "[-]"},
+		Radio:    [2]string{"( )", "(•)"},
+		Switch:   [2]string{"○━━", "━━●"},
+	}
+}
+
+//* Checkbox Render Helper
+/*
+ - Renders the element as a checkbox followed by its label, marked with the element's zone
+ - The indeterminate state is read from a TriStateClickBehaviour
+*/
+func (s ToggleStyles) RenderCheckbox(element Interactive, label string) string {
+	interaction := element.GetInteraction()
+	glyph := s.Checkbox[0]
+	if interaction.IsSelected {
+		glyph = s.Checkbox[1]
+	}
+	if behaviour, ok := interaction.Click.(*TriStateClickBehaviour); ok && behaviour.State == Indeterminate {
+		glyph = s.Checkbox[2]
+	}
+	return s.render(interaction, glyph, label)
+}
+
+//* Radio Render Helper
+/*
+ - Renders the element as a radio button followed by its label, marked with the element's zone
+*/
+func (s ToggleStyles) RenderRadio(element Interactive, label string) string {
+	interaction := element.GetInteraction()
+	glyph := s.Radio[0]
+	if interaction.IsSelected {
+		glyph = s.Radio[1]
+	}
+	return s.render(interaction, glyph, label)
+}
+
+//* Switch Render Helper
+/*
+ - Renders the element as a toggle switch followed by its label, marked with the element's zone
+*/
+func (s ToggleStyles) RenderSwitch(element Interactive, label string) string {
+	interaction := element.GetInteraction()
+	glyph := s.Switch[0]
+	if interaction.IsSelected {
+		glyph = s.Switch[1]
+	}
+	return s.render(interaction, glyph, label)
+}
+
+func (s ToggleStyles) render(interaction *Interactable, glyph, label string) string {
+	style := s.Normal
+	switch {
+	case !interaction.IsEnabled(ClickCapability):
+		style = s.Disabled
+	case interaction.IsHovered:
+		style = s.Hovered
+	case interaction.IsSelected:
+		style = s.Checked
+	}
+	if label != "" {
+		glyph += " " + label
+	}
+	return zone.Mark(interaction.ID, style.Render(glyph))
+}
@@ -0,0 +1,564 @@
+package tree
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jordanella/teaspoon"
+	zone "github.com/lrstanley/bubblezone"
+)
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Drop Positions
+/*
+ - Enum describing where a dragged node will land relative to the node under the pointer
+*/
+type Position int
+
+const (
+	Before Position = iota
+	Into
+	After
+)
+
+//* Toggle Event
+/*
+ - Emitted when a node is expanded or collapsed with its disclosure glyph
+*/
+type ToggleEvent struct {
+	ID       string
+	NodeID   string
+	Expanded bool
+}
+
+//* Open Event
+/*
+ - Emitted when a node is double clicked
+*/
+type OpenEvent struct {
+	ID     string
+	NodeID string
+}
+
+//* Move Event
+/*
+ - Emitted when a node is dropped into, before or after another node
+ - ParentID is empty when the node was moved to the root, Index is its position among its new siblings
+*/
+type MoveEvent struct {
+	ID       string
+	NodeID   string
+	ParentID string
+	Index    int
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Tree Styles
+/*
+ - Styles for rows and drop feedback
+*/
+type Styles struct {
+	Node       lipgloss.Style
+	Hovered    lipgloss.Style
+	Selected   lipgloss.Style
+	Dragging   lipgloss.Style
+	DropInto   lipgloss.Style
+	Indicator  lipgloss.Style
+	Disclosure lipgloss.Style
+}
+
+//* Default Tree Styles
+/*
+ - Returns highlighted selected and hovered rows, with drop feedback in the accent colour
+*/
+func DefaultStyles() Styles {
+	return Styles{
+		Node:       lipgloss.NewStyle(),
+		Hovered:    lipgloss.NewStyle().Foreground(lipgloss.Color("#44aaaa")),
+		Selected:   lipgloss.NewStyle().Reverse(true),
+		Dragging:   lipgloss.NewStyle().Faint(true),
+		DropInto:   lipgloss.NewStyle().Underline(true).Foreground(lipgloss.Color("#44aaaa")),
+		Indicator:  lipgloss.NewStyle().Foreground(lipgloss.Color("#44aaaa")),
+		Disclosure: lipgloss.NewStyle().Faint(true),
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Tree Node
+/*
+ - An interactive node of the tree, which is a leaf unless it has children
+*/
+type Node struct {
+	Label    string
+	Children []*Node
+	Expanded bool
+
+	toggled     bool
+	opened      bool
+	interaction *teaspoon.Interactable
+	disclosure  *disclosure
+}
+
+//* Node Creation Method
+/*
+ - Returns a new collapsed node with the given children
+*/
+func NewNode(label string, children ...*Node) *Node {
+	node := &Node{
+		Label:    label,
+		Children: children,
+		interaction: &teaspoon.Interactable{
+			ID:    zone.NewPrefix(),
+			Hover: &teaspoon.HoverHandler{},
+		},
+	}
+	node.disclosure = &disclosure{
+		node: node,
+		interaction: &teaspoon.Interactable{
+			ID: zone.NewPrefix(),
+			Click: &teaspoon.ClickHandler{
+				OnClick: func(element teaspoon.Interactive, mouseMsg tea.MouseMsg) (teaspoon.Interactive, tea.Cmd) {
+					element.(*disclosure).node.toggled = true
+					return element, nil
+				},
+			},
+		},
+	}
+	return node
+}
+
+//* Implement Interactive Interface
+/*
+ - Provides reference to the node's interaction
+*/
+func (n *Node) GetInteraction() *teaspoon.Interactable {
+	return n.interaction
+}
+
+//* Disclosure Glyph
+/*
+ - Clickable glyph expanding or collapsing its node
+*/
+type disclosure struct {
+	node        *Node
+	interaction *teaspoon.Interactable
+}
+
+func (d *disclosure) GetInteraction() *teaspoon.Interactable {
+	return d.interaction
+}
+
+//* Visible Row
+/*
+ - A node shown in the flattened tree and its depth
+*/
+type row struct {
+	node  *Node
+	depth int
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Tree Model
+/*
+ - Renders nested nodes with expand and collapse, multi-selection and drag to reparent
+ - Clicking selects a node, Ctrl toggles it and Shift extends the selection across visible rows
+ - Dragged nodes drop before, into or after the node under the pointer
+ - The DropHandler's IsAcceptable vetoes moving a node into itself or its descendants, and may be replaced
+*/
+type Model struct {
+	Roots  []*Node
+	Styles Styles
+
+	rows        []row
+	target      *Node
+	position    Position
+	selection   *teaspoon.SelectionGroup
+	drag        *teaspoon.DragHandler
+	drop        *teaspoon.DropHandler
+	interaction *teaspoon.Interactable
+}
+
+//* Creation Method
+/*
+ - Returns a new tree with the provided root nodes
+*/
+func New(roots ...*Node) Model {
+	id := zone.NewPrefix()
+
+	drop := &teaspoon.DropHandler{
+		AcceptedDropTypes: []string{id},
+	}
+	drop.IsAcceptable = func(element teaspoon.Interactive, dragEvent teaspoon.DragEvent) bool {
+		m := element.(Model)
+		if !drop.DefaultIsAcceptable(m, dragEvent) {
+			return false
+		}
+		node := m.Find(dragEvent.ID)
+		target, _ := m.DropTarget(dragEvent.MouseMsg)
+		return node != nil && target != nil && !IsDescendant(node, target)
+	}
+	drop.OnDropHover = func(element teaspoon.Interactive, dragEvent teaspoon.DragEvent) (teaspoon.Interactive, tea.Cmd) {
+		m := element.(Model)
+		m.target, m.position = m.DropTarget(dragEvent.MouseMsg)
+		return drop.DefaultDropHover(m, dragEvent)
+	}
+	drop.OnDropLeave = func(element teaspoon.Interactive, dragEvent teaspoon.DragEvent) (teaspoon.Interactive, tea.Cmd) {
+		m := element.(Model)
+		m.target = nil
+		return drop.DefaultDropLeave(m, dragEvent)
+	}
+	drop.OnDropRelease = func(element teaspoon.Interactive, dragEvent teaspoon.DragEvent) (teaspoon.Interactive, tea.Cmd) {
+		m := element.(Model)
+		m.target = nil
+		var cmd tea.Cmd
+		if drop.HandleIsAcceptable(m, dragEvent) {
+			target, position := m.DropTarget(dragEvent.MouseMsg)
+			m, cmd = m.Move(dragEvent.ID, target, position)
+		}
+		element, releaseCmd := drop.DefaultDropRelease(m, dragEvent)
+		return element, tea.Batch(cmd, releaseCmd)
+	}
+
+	m := Model{
+		Roots:     roots,
+		Styles:    DefaultStyles(),
+		selection: teaspoon.NewSelectionGroup(teaspoon.MultipleSelection),
+		drag: &teaspoon.DragHandler{
+			DragType:     id,
+			EmitMessages: true,
+		},
+		drop: drop,
+		interaction: &teaspoon.Interactable{
+			ID:   id,
+			Drop: drop,
+		},
+	}
+	m.flatten()
+	return m
+}
+
+//* Implement Interactive Interface
+/*
+ - Provides reference to the tree's interaction, used as the drop target for its nodes
+*/
+func (m Model) GetInteraction() *teaspoon.Interactable {
+	return m.interaction
+}
+
+//* Tree Drop Handler
+/*
+ - Provides access to the tree's acceptance rules so IsAcceptable can be replaced or extended
+*/
+func (m Model) DropHandler() *teaspoon.DropHandler {
+	return m.drop
+}
+
+//* Selected Nodes
+/*
+ - Returns the selected visible nodes in order
+*/
+func (m Model) Selected() []*Node {
+	var nodes []*Node
+	for _, member := range m.selection.Selected() {
+		nodes = append(nodes, member.(*Node))
+	}
+	return nodes
+}
+
+//* Find Node
+/*
+ - Returns the node with the given ID, or nil if it is not within the tree
+*/
+func (m Model) Find(id string) *Node {
+	node, _, _ := find(m.Roots, nil, id)
+	return node
+}
+
+//* Is Descendant
+/*
+ - Returns true if candidate is node itself or is nested anywhere beneath it
+*/
+func IsDescendant(node, candidate *Node) bool {
+	if node == candidate {
+		return true
+	}
+	for _, child := range node.Children {
+		if IsDescendant(child, candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+//* Drop Target
+/*
+ - Returns the visible node under the pointer and where a drop would land relative to it
+ - Rows of three or more lines are split vertically into thirds, single line rows are split horizontally
+*/
+func (m Model) DropTarget(mouseMsg tea.MouseMsg) (*Node, Position) {
+	for _, r := range m.rows {
+		bounds := zone.Get(r.node.interaction.ID)
+		if !bounds.InBounds(mouseMsg) {
+			continue
+		}
+
+		offset, length := mouseMsg.Y-bounds.StartY, bounds.EndY-bounds.StartY+1
+		if length < 3 {
+			offset, length = mouseMsg.X-bounds.StartX, bounds.EndX-bounds.StartX+1
+		}
+		switch {
+		case 3*offset < length:
+			return r.node, Before
+		case 3*offset >= 2*length:
+			return r.node, After
+		}
+		return r.node, Into
+	}
+	return nil, Into
+}
+
+//* Move Node
+/*
+ - Moves the node with the given ID before, into or after the target and emits a MoveEvent
+ - Does nothing if the target is the node itself or one of its descendants
+*/
+func (m Model) Move(id string, target *Node, position Position) (Model, tea.Cmd) {
+	node, parent, index := find(m.Roots, nil, id)
+	if node == nil || target == nil || IsDescendant(node, target) {
+		return m, nil
+	}
+
+	if parent == nil {
+		m.Roots = remove(m.Roots, index)
+	} else {
+		parent.Children = remove(parent.Children, index)
+	}
+
+	var newParent *Node
+	if position == Into {
+		newParent = target
+		target.Children = append(target.Children, node)
+		target.Expanded = true
+		index = len(target.Children) - 1
+	} else {
+		_, newParent, index = find(m.Roots, nil, target.interaction.ID)
+		if position == After {
+			index++
+		}
+		if newParent == nil {
+			m.Roots = insert(m.Roots, index, node)
+		} else {
+			newParent.Children = insert(newParent.Children, index, node)
+		}
+	}
+	m.flatten()
+
+	moveEvent := MoveEvent{ID: m.interaction.ID, NodeID: id, Index: index}
+	if newParent != nil {
+		moveEvent.ParentID = newParent.interaction.ID
+	}
+	return m, func() tea.Msg {
+		return moveEvent
+	}
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Update Routine
+/*
+ - Mouse messages are passed to every visible node and disclosure glyph, then resolved into toggle and open events
+ - Drag events are assessed by the tree for reparenting, other messages are passed to the nodes to cancel drags
+*/
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	var cmd tea.Cmd
+	var cmds []tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.MouseMsg:
+		if tea.MouseEvent(msg).IsWheel() {
+			return m, nil
+		}
+
+		for _, r := range m.rows {
+			if len(r.node.Children) > 0 {
+				if _, cmd = r.node.disclosure.interaction.HandleMouseMsg(r.node.disclosure, msg); cmd != nil {
+					cmds = append(cmds, cmd)
+				}
+			}
+			if _, cmd = r.node.interaction.HandleMouseMsg(r.node, msg); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+
+		for _, r := range m.rows {
+			node := r.node
+			if node.toggled {
+				node.toggled = false
+				node.Expanded = !node.Expanded
+				toggleEvent := ToggleEvent{ID: m.interaction.ID, NodeID: node.interaction.ID, Expanded: node.Expanded}
+				cmds = append(cmds, func() tea.Msg {
+					return toggleEvent
+				})
+			}
+			if node.opened {
+				node.opened = false
+				openEvent := OpenEvent{ID: m.interaction.ID, NodeID: node.interaction.ID}
+				cmds = append(cmds, func() tea.Msg {
+					return openEvent
+				})
+			}
+		}
+		m.flatten()
+		return m, tea.Batch(cmds...)
+
+	case teaspoon.DragEvent:
+		var element teaspoon.Interactive
+		element, cmd = m.interaction.HandleExternalEvent(m, msg)
+		m = element.(Model)
+		cmds = append(cmds, cmd)
+	}
+
+	for _, r := range m.rows {
+		if _, cmd = r.node.interaction.HandleExternalEvent(r.node, msg); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Tree View
+/*
+ - Renders the visible nodes indented by depth, with an indicator line for drops before or after a node
+*/
+func (m Model) View() string {
+	width := 0
+	for _, r := range m.rows {
+		width = max(width, 2*r.depth+2+lipgloss.Width(r.node.Label))
+	}
+
+	var lines []string
+	for _, r := range m.rows {
+		node := r.node
+		indicator := m.Styles.Indicator.Render(strings.Repeat(" ", 2*r.depth) + strings.Repeat("─", max(width-2*r.depth, 1)))
+		dropping := node == m.target && m.interaction.IsValidDrop
+
+		if dropping && m.position == Before {
+			lines = append(lines, indicator)
+		}
+
+		glyph := " "
+		if len(node.Children) > 0 {
+			glyph = "▸"
+			if node.Expanded {
+				glyph = "▾"
+			}
+			glyph = zone.Mark(node.disclosure.interaction.ID, m.Styles.Disclosure.Render(glyph))
+		}
+
+		interaction := node.interaction
+		style := m.Styles.Node
+		switch {
+		case interaction.IsDragging:
+			style = m.Styles.Dragging
+		case dropping && m.position == Into:
+			style = m.Styles.DropInto
+		case interaction.IsSelected:
+			style = m.Styles.Selected
+		case interaction.IsHovered:
+			style = m.Styles.Hovered
+		}
+
+		line := strings.Repeat(" ", 2*r.depth) + glyph + " " + style.Render(node.Label)
+		lines = append(lines, zone.Mark(interaction.ID, line))
+
+		if dropping && m.position == After {
+			lines = append(lines, indicator)
+		}
+	}
+
+	return zone.Mark(m.interaction.ID, lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+//?--------------------------------------------------------------------------------------------------------------------
+
+//* Flatten
+/*
+ - Rebuilds the visible rows from the expanded nodes, preparing each node and keeping the selection group in row order
+*/
+func (m *Model) flatten() {
+	m.rows = m.rows[:0:0]
+
+	var walk func(nodes []*Node, depth int)
+	walk = func(nodes []*Node, depth int) {
+		for _, node := range nodes {
+			m.prepare(node)
+			m.rows = append(m.rows, row{node: node, depth: depth})
+			if node.Expanded {
+				walk(node.Children, depth+1)
+			}
+		}
+	}
+	walk(m.Roots, 0)
+
+	members := make([]teaspoon.Interactive, len(m.rows))
+	for i, r := range m.rows {
+		members[i] = r.node
+	}
+	m.selection.SetMembers(members...)
+}
+
+func (m Model) prepare(node *Node) {
+	interaction := node.interaction
+	if interaction.Drag == nil {
+		interaction.Drag = m.drag
+	}
+	if interaction.Click == nil {
+		selection := m.selection
+		interaction.Click = &teaspoon.ClickHandler{
+			OnClick: func(element teaspoon.Interactive, mouseMsg tea.MouseMsg) (teaspoon.Interactive, tea.Cmd) {
+				node := element.(*Node)
+				if len(node.Children) > 0 && node.disclosure.interaction.HandleIsInside(node.disclosure, mouseMsg) {
+					return element, nil
+				}
+				return selection.HandleClick(element, mouseMsg)
+			},
+			OnDoubleClick: func(element teaspoon.Interactive, mouseMsg tea.MouseMsg) (teaspoon.Interactive, tea.Cmd) {
+				node := element.(*Node)
+				if len(node.Children) > 0 && node.disclosure.interaction.HandleIsInside(node.disclosure, mouseMsg) {
+					return element, nil
+				}
+				node.opened = true
+				return element, nil
+			},
+		}
+	}
+}
+
+func find(nodes []*Node, parent *Node, id string) (*Node, *Node, int) {
+	for i, node := range nodes {
+		if node.interaction.ID == id {
+			return node, parent, i
+		}
+		if found, foundParent, index := find(node.Children, node, id); found != nil {
+			return found, foundParent, index
+		}
+	}
+	return nil, nil, -1
+}
+
+func remove(nodes []*Node, index int) []*Node {
+	return append(nodes[:index:index], nodes[index+1:]...)
+}
+
+func insert(nodes []*Node, index int, node *Node) []*Node {
+	index = max(0, min(index, len(nodes)))
+	return append(nodes[:index:index], append([]*Node{node}, nodes[index:]...)...)
+}
@@ -0,0 +1,73 @@
+package tree
+
+import (
+	"strings"
+	"testing"
+
+	zone "github.com/lrstanley/bubblezone"
+)
+
+// Renders the nodes as labels, with children in parentheses after their parent
+func outline(nodes []*Node) string {
+	var parts []string
+	for _, node := range nodes {
+		part := node.Label
+		if len(node.Children) > 0 {
+			part += "(" + outline(node.Children) + ")"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestTreeMove(t *testing.T) {
+	zone.NewGlobal()
+
+	tests := []struct {
+		name     string
+		node     string
+		target   string
+		position Position
+		want     string
+		parent   string
+		index    int
+		moved    bool
+	}{
+		{name: "into a leaf", node: "e", target: "b", position: Into, want: "a(b(e) c(d))", parent: "b", index: 0, moved: true},
+		{name: "before a sibling of another parent", node: "d", target: "b", position: Before, want: "a(d b c) e", parent: "a", index: 0, moved: true},
+		{name: "after a root", node: "b", target: "e", position: After, want: "a(c(d)) e b", index: 2, moved: true},
+		{name: "into its own descendant", node: "a", target: "d", position: Into, want: "a(b c(d)) e"},
+		{name: "into itself", node: "c", target: "c", position: Into, want: "a(b c(d)) e"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nodes := map[string]*Node{}
+			for _, label := range []string{"b", "d", "e"} {
+				nodes[label] = NewNode(label)
+			}
+			nodes["c"] = NewNode("c", nodes["d"])
+			nodes["a"] = NewNode("a", nodes["b"], nodes["c"])
+			m := New(nodes["a"], nodes["e"])
+
+			m, cmd := m.Move(nodes[test.node].interaction.ID, nodes[test.target], test.position)
+			if got := outline(m.Roots); got != test.want {
+				t.Errorf("tree %q, want %q", got, test.want)
+			}
+			if (cmd != nil) != test.moved {
+				t.Fatalf("move event %v, want %v", cmd != nil, test.moved)
+			}
+			if cmd == nil {
+				return
+			}
+
+			want := MoveEvent{ID: m.interaction.ID, NodeID: nodes[test.node].interaction.ID, Index: test.index}
+			if test.parent != "" {
+				want.ParentID = nodes[test.parent].interaction.ID
+			}
+			if moveEvent := cmd(); moveEvent != want {
+				t.Errorf("event %+v, want %+v", moveEvent, want)
+			}
+		})
+	}
+}